@@ -1,6 +1,12 @@
 package cmd
 
 import (
+	"context"
+	"github.com/gocomply/xsd2go/pkg/fetch"
+	"github.com/gocomply/xsd2go/pkg/infer"
+	"github.com/gocomply/xsd2go/pkg/reverse"
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
 	"github.com/gocomply/xsd2go/pkg/xsd2go"
 	"github.com/urfave/cli"
 	"os"
@@ -13,6 +19,13 @@ func Execute() error {
 	app.Usage = "Automatically generate golang xml parser based on XSD"
 	app.Commands = []cli.Command{
 		convert,
+		wsdlCmd,
+		dtdCmd,
+		rngCmd,
+		reverseCmd,
+		inferCmd,
+		validateCmd,
+		fetchCmd,
 	}
 
 	return app.Run(os.Args)
@@ -21,7 +34,41 @@ func Execute() error {
 var convert = cli.Command{
 	Name:      "convert",
 	Usage:     "convert XSD to golang code to parse xml files generated by given xsd",
-	ArgsUsage: "XSD-FILE GO-MODULE-IMPORT OUTPUT-DIR",
+	ArgsUsage: "XSD-FILE [XSD-FILE...] GO-MODULE-IMPORT OUTPUT-DIR",
+	Flags:     generateFlags,
+	Before: func(c *cli.Context) error {
+		if c.NArg() < 3 {
+			return cli.NewExitError("At least 3 arguments are required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		n := c.NArg()
+		return generate(c, xsd2go.Options{XSDPaths: c.Args()[:n-2]})
+	},
+}
+
+var wsdlCmd = cli.Command{
+	Name:      "wsdl",
+	Usage:     "convert schemas embedded in WSDL 1.1 to golang code and generate SOAP client of its services",
+	ArgsUsage: "WSDL-FILE GO-MODULE-IMPORT OUTPUT-DIR",
+	Flags:     generateFlags,
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 3 {
+			return cli.NewExitError("Exactly 3 arguments are required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		return generate(c, xsd2go.Options{WSDLPath: c.Args()[0]})
+	},
+}
+
+var dtdCmd = cli.Command{
+	Name:      "dtd",
+	Usage:     "convert DTD to golang code to parse xml files of given document type",
+	ArgsUsage: "DTD-FILE GO-MODULE-IMPORT OUTPUT-DIR",
+	Flags:     generateFlags,
 	Before: func(c *cli.Context) error {
 		if c.NArg() != 3 {
 			return cli.NewExitError("Exactly 3 arguments are required", 1)
@@ -29,8 +76,320 @@ var convert = cli.Command{
 		return nil
 	},
 	Action: func(c *cli.Context) error {
-		xsdFile, goModule, outputDir := c.Args()[0], c.Args()[1], c.Args()[2]
-		err := xsd2go.Convert(xsdFile, goModule, outputDir)
+		return generate(c, xsd2go.Options{DTDPath: c.Args()[0]})
+	},
+}
+
+var rngCmd = cli.Command{
+	Name:      "rng",
+	Usage:     "convert RELAX NG grammar in XML or compact syntax to golang code to parse xml files it defines",
+	ArgsUsage: "RNG-FILE GO-MODULE-IMPORT OUTPUT-DIR",
+	Flags:     generateFlags,
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 3 {
+			return cli.NewExitError("Exactly 3 arguments are required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		return generate(c, xsd2go.Options{RNGPath: c.Args()[0]})
+	},
+}
+
+// generateFlags are shared by convert, wsdl, dtd and rng commands
+var generateFlags = append(append([]cli.Flag{
+	cli.StringFlag{
+		Name:  "template-dir",
+		Usage: "directory with *.tmpl files overriding or extending built-in templates",
+	},
+	cli.BoolFlag{
+		Name:  "json-tags",
+		Usage: "add json struct tags to generated fields",
+	},
+	cli.BoolFlag{
+		Name:  "yaml-tags",
+		Usage: "add yaml struct tags to generated fields",
+	},
+	cli.StringFlag{
+		Name:  "tag-naming",
+		Value: template.TagNamingCamel,
+		Usage: "naming style of json and yaml tags: camel, snake or original",
+	},
+	cli.BoolFlag{
+		Name:  "xml-codecs",
+		Usage: "generate UnmarshalXML and MarshalXML methods that avoid encoding/xml reflection",
+	},
+	cli.StringFlag{
+		Name:  "mixed-content",
+		Value: template.MixedContentInnerXml,
+		Usage: "representation of mixed content: innerxml or nodes",
+	},
+	cli.StringFlag{
+		Name:  "order",
+		Value: xsd.TypeOrderDeclaration,
+		Usage: "order of generated packages and types: declaration or name",
+	},
+	cli.StringFlag{
+		Name:  "layout",
+		Value: template.LayoutSingle,
+		Usage: "files generated structs are split to: single, component, source or kind",
+	},
+	cli.BoolFlag{
+		Name:  "check",
+		Usage: "do not write files, exit with error when generated code in OUTPUT-DIR is out of date",
+	},
+	cli.BoolFlag{
+		Name:  "diff",
+		Usage: "like --check, additionally print unified diff of out of date files",
+	},
+}, schemaFlags...), logFlags...)
+
+// schemaFlags select how schemas and their imports are loaded, shared by generating
+// commands and validate, see workspaceOptions
+var schemaFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name:  "catalog",
+		Usage: "OASIS XML catalog mapping schema locations to local files, may be repeated",
+	},
+	cli.StringSliceFlag{
+		Name:  "schema-dir",
+		Usage: "directory scanned for schemas of namespaces imported without schemaLocation, may be repeated",
+	},
+	cli.BoolFlag{
+		Name:  "fetch",
+		Usage: "download http(s) schemas not mapped by a catalog, caching them in --cache-dir",
+	},
+	cli.StringFlag{
+		Name:  "cache-dir",
+		Value: fetch.DefaultDir(),
+		Usage: "directory remote schemas are cached in",
+	},
+	cli.BoolFlag{
+		Name:  "offline",
+		Usage: "use only remote schemas cached already, see fetch command",
+	},
+	cli.StringFlag{
+		Name:  "xsd-version",
+		Value: xsd.XSDVersion11,
+		Usage: "XSD version schemas are processed as: 1.0 rejects XSD 1.1 constructs, 1.1",
+	},
+}
+
+// logFlags select progress messages printed by all commands, see logLevel
+var logFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "quiet, q",
+		Usage: "do not print progress messages",
+	},
+	cli.BoolFlag{
+		Name:  "verbose, v",
+		Usage: "print also resolved imports, renamed fields and skipped constructs",
+	},
+}
+
+// generate runs code generation with options given by the command line, the last two
+// arguments are the go module and output directory
+func generate(c *cli.Context, genOpts xsd2go.Options) error {
+	n := c.NArg()
+	genOpts.GoModule, genOpts.OutputDir = c.Args()[n-2], c.Args()[n-1]
+	genOpts.Template = template.Options{
+		TemplateDir:  c.String("template-dir"),
+		JSONTags:     c.Bool("json-tags"),
+		YAMLTags:     c.Bool("yaml-tags"),
+		TagNaming:    c.String("tag-naming"),
+		XMLCodecs:    c.Bool("xml-codecs"),
+		MixedContent: c.String("mixed-content"),
+		Order:        c.String("order"),
+		Layout:       c.String("layout"),
+	}
+	wsOpts, err := workspaceOptions(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	genOpts.Catalog = wsOpts.Catalog
+	genOpts.SchemaDirs = wsOpts.SchemaDirs
+	genOpts.Resolver = wsOpts.Resolver
+	genOpts.XSDVersion = wsOpts.XSDVersion
+	genOpts.Logger = wsOpts.Logger
+	if c.Bool("diff") {
+		genOpts.Diff = os.Stdout
+	}
+	if c.Bool("check") || c.Bool("diff") {
+		err = xsd2go.Check(context.Background(), genOpts)
+	} else {
+		genOpts.Sink = xsd2go.DirSink(genOpts.OutputDir)
+		_, err = xsd2go.Generate(context.Background(), genOpts)
+	}
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	return nil
+}
+
+// workspaceOptions loads schemas the way schemaFlags and logFlags select
+func workspaceOptions(c *cli.Context) (xsd.WorkspaceOptions, error) {
+	catalog, err := xsd.LoadCatalog(nil, c.StringSlice("catalog")...)
+	if err != nil {
+		return xsd.WorkspaceOptions{}, err
+	}
+	opts := xsd.WorkspaceOptions{
+		Logger:     logger(c),
+		Catalog:    catalog,
+		SchemaDirs: c.StringSlice("schema-dir"),
+		XSDVersion: c.String("xsd-version"),
+	}
+	// Network is used only when asked for, --offline reads schemas the fetch command cached
+	if c.Bool("fetch") || c.Bool("offline") {
+		opts.Resolver = &fetch.Cache{Dir: c.String("cache-dir"), Offline: c.Bool("offline")}
+	}
+	return opts, nil
+}
+
+// logger prints progress messages to stdout at level given by logFlags
+func logger(c *cli.Context) xsd.Logger {
+	return xsd.NewLogger(os.Stdout, logLevel(c))
+}
+
+func logLevel(c *cli.Context) xsd.Level {
+	if c.Bool("quiet") {
+		return xsd.LevelQuiet
+	} else if c.Bool("verbose") {
+		return xsd.LevelVerbose
+	}
+	return xsd.LevelInfo
+}
+
+var reverseCmd = cli.Command{
+	Name:      "reverse",
+	Usage:     "generate XSD describing golang structs annotated with xml tags",
+	ArgsUsage: "GO-PACKAGE-DIR OUTPUT-XSD",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "namespace",
+			Usage: "targetNamespace of the generated schema",
+		},
+		cli.StringFlag{
+			Name:  "prefix",
+			Usage: "xmlns prefix of the target namespace (defaults to go package name)",
+		},
+	}, logFlags...),
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 2 {
+			return cli.NewExitError("Exactly 2 arguments are required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		goPackageDir, xsdFile := c.Args()[0], c.Args()[1]
+		opts := reverse.Options{
+			TargetNamespace: c.String("namespace"),
+			Prefix:          c.String("prefix"),
+		}
+		err := xsd2go.Reverse(goPackageDir, xsdFile, opts, logger(c))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+var inferCmd = cli.Command{
+	Name:      "infer",
+	Usage:     "infer XSD from sample xml documents",
+	ArgsUsage: "XML-FILE [XML-FILE...] OUTPUT-XSD",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "prefix",
+			Usage: "xmlns prefix of the target namespace (defaults to root element name)",
+		},
+		cli.IntFlag{
+			Name:  "max-enum-values",
+			Value: 10,
+			Usage: "maximum number of distinct values to infer enumeration, 0 disables enumerations",
+		},
+	}, logFlags...),
+	Before: func(c *cli.Context) error {
+		if c.NArg() < 2 {
+			return cli.NewExitError("At least 2 arguments are required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		xmlFiles, xsdFile := c.Args()[:c.NArg()-1], c.Args()[c.NArg()-1]
+		opts := infer.Options{
+			Prefix:        c.String("prefix"),
+			MaxEnumValues: c.Int("max-enum-values"),
+		}
+		err := xsd2go.Infer(xmlFiles, xsdFile, opts, logger(c))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+var validateCmd = cli.Command{
+	Name:      "validate",
+	Usage:     "validate xml documents against XSD",
+	ArgsUsage: "XSD-FILE XML-FILE [XML-FILE...]",
+	Flags:     append(append([]cli.Flag{}, schemaFlags...), logFlags...),
+	Before: func(c *cli.Context) error {
+		if c.NArg() < 2 {
+			return cli.NewExitError("At least 2 arguments are required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		xsdFile, xmlFiles := c.Args()[0], c.Args()[1:]
+		opts, err := workspaceOptions(c)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		err = xsd2go.Validate(xsdFile, xmlFiles, opts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+var fetchCmd = cli.Command{
+	Name:      "fetch",
+	Usage:     "download remote schemas imported by XSD to the cache used by convert --offline",
+	ArgsUsage: "XSD-FILE-OR-URL [XSD-FILE-OR-URL...]",
+	Flags: append([]cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "schema-dir",
+			Usage: "directory scanned for schemas of namespaces imported without schemaLocation, may be repeated",
+		},
+		cli.StringFlag{
+			Name:  "cache-dir",
+			Value: fetch.DefaultDir(),
+			Usage: "directory remote schemas are cached in",
+		},
+		cli.StringSliceFlag{
+			Name:  "catalog",
+			Usage: "OASIS XML catalog mapping schema locations to local files, may be repeated",
+		},
+	}, logFlags...),
+	Before: func(c *cli.Context) error {
+		if c.NArg() < 1 {
+			return cli.NewExitError("At least 1 argument is required", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		catalog, err := xsd.LoadCatalog(nil, c.StringSlice("catalog")...)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		_, err = xsd2go.Fetch(xsd2go.Options{
+			XSDPaths:   c.Args(),
+			Catalog:    catalog,
+			SchemaDirs: c.StringSlice("schema-dir"),
+			Resolver:   &fetch.Cache{Dir: c.String("cache-dir"), Refresh: true},
+			Logger:     logger(c),
+		})
 		if err != nil {
 			return cli.NewExitError(err, 1)
 		}
@@ -1,10 +1,11 @@
 module github.com/gocomply/xsd2go
 
-go 1.13
+go 1.16
 
 require (
 	github.com/iancoleman/strcase v0.0.0-20191112232945-16388991a334
 	github.com/markbates/pkger v0.17.1
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.6.1
 	github.com/urfave/cli v1.22.4
 )
@@ -0,0 +1,91 @@
+// Package dtd reads XML Document Type Definitions and converts them to xsd.Schema, so that
+// DTD defined documents get go structs from the same templates as XSD defined ones.
+//
+// Element declarations, content models, attribute lists, conditional sections and
+// parameter entities (internal and external ones stored in local files) are supported.
+// General entities and notations are not needed for code generation and are ignored.
+package dtd
+
+import (
+	"path"
+	"strings"
+)
+
+// DTD is parsed document type definition
+type DTD struct {
+	path     string
+	elements []*elementDecl
+	// attributes maps element names to their attribute declarations in declaration order
+	attributes map[string][]attributeDecl
+}
+
+// Content kinds of element declarations
+const (
+	contentEmpty    = "EMPTY"
+	contentAny      = "ANY"
+	contentMixed    = "mixed"
+	contentChildren = "children"
+)
+
+type elementDecl struct {
+	name    string
+	content string
+	// model of element content, group of contentChildren
+	model *particle
+	// mixed are elements allowed among text of contentMixed
+	mixed []string
+}
+
+// particle of content model: element name or sequence (',') or choice ('|') group
+type particle struct {
+	name     string
+	group    byte
+	children []*particle
+	// occurs is "", "?", "*" or "+"
+	occurs string
+}
+
+type attributeDecl struct {
+	name string
+	// typ is CDATA, ID, IDREF, IDREFS, ENTITY, ENTITIES, NMTOKEN, NMTOKENS, NOTATION or
+	// enumeration for (a|b) lists
+	typ    string
+	values []string
+	// mode is #REQUIRED, #IMPLIED, #FIXED or empty when the value is default
+	mode  string
+	value string
+}
+
+// Parse reads DTD from dtdPath. The read function loads the DTD and external parameter
+// entities it refers to, these are given slash separated paths relative to the directory
+// of the referring file.
+func Parse(dtdPath string, read func(path string) ([]byte, error)) (*DTD, error) {
+	data, err := read(dtdPath)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{
+		dtd:       &DTD{path: dtdPath, attributes: map[string][]attributeDecl{}},
+		read:      read,
+		entities:  map[string]*entity{},
+		expanding: map[string]bool{},
+	}
+	if err := p.parse(string(data), dtdPath); err != nil {
+		return nil, err
+	}
+	return p.dtd, nil
+}
+
+// SchemaPath names schema converted from the DTD, the generated go package is named after it
+func (d *DTD) SchemaPath() string {
+	return strings.TrimSuffix(d.path, path.Ext(d.path)) + ".xsd"
+}
+
+func (d *DTD) element(name string) *elementDecl {
+	for _, el := range d.elements {
+		if el.name == name {
+			return el
+		}
+	}
+	return nil
+}
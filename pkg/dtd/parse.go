@@ -0,0 +1,389 @@
+package dtd
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// entity is parameter entity, either internal (value) or stored in external file (system)
+type entity struct {
+	value  string
+	system string
+	// base is path of the file declaring the entity, external entities are relative to it
+	base string
+}
+
+type parser struct {
+	dtd      *DTD
+	read     func(path string) ([]byte, error)
+	entities map[string]*entity
+	// expanding guards against recursive parameter entities
+	expanding map[string]bool
+}
+
+// parse processes markup declarations of DTD or of external parameter entity stored in base
+func (p *parser) parse(text, base string) error {
+	s := &scanner{text: text}
+	for {
+		s.skipSpace()
+		switch {
+		case s.eof():
+			return nil
+		case s.consume("<!--"):
+			if !s.skipPast("-->") {
+				return fmt.Errorf("Unterminated comment in %s", base)
+			}
+		case s.consume("<?"):
+			if !s.skipPast("?>") {
+				return fmt.Errorf("Unterminated processing instruction in %s", base)
+			}
+		case s.consume("<!["):
+			if err := p.conditionalSection(s, base); err != nil {
+				return err
+			}
+		case s.consume("<!"):
+			decl, ok := s.declaration()
+			if !ok {
+				return fmt.Errorf("Unterminated declaration <!%s in %s", abbreviate(decl), base)
+			}
+			if err := p.declare(decl, base); err != nil {
+				return fmt.Errorf("Invalid declaration <!%s> in %s: %s", abbreviate(decl), base, err)
+			}
+		case s.peek() == '%':
+			name, ok := s.reference()
+			if !ok {
+				return fmt.Errorf("Invalid parameter entity reference in %s", base)
+			}
+			if err := p.include(name, base); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("Unexpected '%s' in %s", abbreviate(s.rest()), base)
+		}
+	}
+}
+
+// conditionalSection processes <![INCLUDE[...]]> or skips <![IGNORE[...]]>
+func (p *parser) conditionalSection(s *scanner, base string) error {
+	open := strings.IndexByte(s.rest(), '[')
+	if open == -1 {
+		return fmt.Errorf("Invalid conditional section in %s", base)
+	}
+	keyword, err := p.expand(s.rest()[:open])
+	if err != nil {
+		return err
+	}
+	s.pos += open + 1
+	content, ok := s.conditionalContent()
+	if !ok {
+		return fmt.Errorf("Unterminated conditional section in %s", base)
+	}
+	switch strings.TrimSpace(keyword) {
+	case "INCLUDE":
+		return p.parse(content, base)
+	case "IGNORE":
+		return nil
+	default:
+		return fmt.Errorf("Conditional section in %s must be INCLUDE or IGNORE, got '%s'", base, strings.TrimSpace(keyword))
+	}
+}
+
+// include processes markup declarations of parameter entity referenced between declarations
+func (p *parser) include(name, base string) error {
+	text, entityBase, err := p.entityText(name)
+	if err != nil {
+		return fmt.Errorf("%s in %s", err, base)
+	}
+	p.expanding[name] = true
+	defer delete(p.expanding, name)
+	return p.parse(text, entityBase)
+}
+
+func (p *parser) declare(decl, base string) error {
+	keyword := decl
+	if idx := strings.IndexAny(decl, " \t\r\n%"); idx != -1 {
+		keyword = decl[:idx]
+	}
+	body := decl[len(keyword):]
+	if keyword == "ENTITY" {
+		return p.declareEntity(body, base)
+	}
+	body, err := p.expand(body)
+	if err != nil {
+		return err
+	}
+	switch keyword {
+	case "ELEMENT":
+		return p.declareElement(&tokenizer{text: body})
+	case "ATTLIST":
+		return p.declareAttributes(&tokenizer{text: body})
+	case "NOTATION":
+		return nil
+	default:
+		return fmt.Errorf("unknown declaration %s", keyword)
+	}
+}
+
+func (p *parser) declareEntity(body, base string) error {
+	t := &tokenizer{text: body}
+	if t.peek() != "%" {
+		// General entities do not affect the structure of documents
+		return nil
+	}
+	t.next()
+	name := t.next()
+	e := &entity{base: base}
+	switch tok := t.next(); {
+	case isQuoted(tok):
+		value, err := p.expand(unquote(tok))
+		if err != nil {
+			return err
+		}
+		e.value = replaceCharRefs(value)
+	case tok == "SYSTEM":
+		e.system = unquote(t.next())
+	case tok == "PUBLIC":
+		t.next()
+		e.system = unquote(t.next())
+	default:
+		return fmt.Errorf("invalid value of entity %s", name)
+	}
+	if _, found := p.entities[name]; !found {
+		// The first declaration is binding
+		p.entities[name] = e
+	}
+	return nil
+}
+
+func (p *parser) declareElement(t *tokenizer) error {
+	el := &elementDecl{name: t.next()}
+	if el.name == "" {
+		return fmt.Errorf("element name missing")
+	}
+	if p.dtd.element(el.name) != nil {
+		return fmt.Errorf("element %s is declared more than once", el.name)
+	}
+	switch tok := t.next(); tok {
+	case contentEmpty, contentAny:
+		el.content = tok
+	case "(":
+		if t.peek() == "#PCDATA" {
+			t.next()
+			el.content = contentMixed
+			for t.peek() == "|" {
+				t.next()
+				el.mixed = append(el.mixed, t.next())
+			}
+			if t.next() != ")" {
+				return fmt.Errorf("mixed content of %s must end with ')'", el.name)
+			}
+			if t.peek() == "*" {
+				t.next()
+			} else if len(el.mixed) > 0 {
+				return fmt.Errorf("mixed content of %s must end with ')*'", el.name)
+			}
+			break
+		}
+		model, err := parseGroup(t)
+		if err != nil {
+			return err
+		}
+		el.content, el.model = contentChildren, model
+	default:
+		return fmt.Errorf("invalid content of element %s", el.name)
+	}
+	if rest := t.next(); rest != "" {
+		return fmt.Errorf("unexpected '%s' in declaration of element %s", rest, el.name)
+	}
+	p.dtd.elements = append(p.dtd.elements, el)
+	return nil
+}
+
+// parseGroup parses content model group following its opening parenthesis
+func parseGroup(t *tokenizer) (*particle, error) {
+	group := &particle{group: ','}
+	for {
+		child, err := parseParticle(t)
+		if err != nil {
+			return nil, err
+		}
+		group.children = append(group.children, child)
+		switch sep := t.next(); sep {
+		case ")":
+			group.occurs = t.occurrence()
+			return group, nil
+		case ",", "|":
+			if len(group.children) > 1 && group.group != sep[0] {
+				return nil, fmt.Errorf("content model mixes ',' and '|' within one group")
+			}
+			group.group = sep[0]
+		default:
+			return nil, fmt.Errorf("unexpected '%s' in content model", sep)
+		}
+	}
+}
+
+func parseParticle(t *tokenizer) (*particle, error) {
+	tok := t.next()
+	if tok == "(" {
+		return parseGroup(t)
+	}
+	if !isName(tok) {
+		return nil, fmt.Errorf("unexpected '%s' in content model", tok)
+	}
+	return &particle{name: tok, occurs: t.occurrence()}, nil
+}
+
+func (p *parser) declareAttributes(t *tokenizer) error {
+	element := t.next()
+	if element == "" {
+		return fmt.Errorf("element name missing")
+	}
+	for {
+		attr := attributeDecl{name: t.next()}
+		if attr.name == "" {
+			return nil
+		}
+		attr.typ = t.next()
+		switch attr.typ {
+		case "CDATA", "ID", "IDREF", "IDREFS", "ENTITY", "ENTITIES", "NMTOKEN", "NMTOKENS":
+		case "NOTATION", "(":
+			if attr.typ == "NOTATION" && t.next() != "(" {
+				return fmt.Errorf("notations of attribute %s must be enclosed in parentheses", attr.name)
+			}
+			attr.typ = "enumeration"
+			for {
+				attr.values = append(attr.values, t.next())
+				if sep := t.next(); sep == ")" {
+					break
+				} else if sep != "|" {
+					return fmt.Errorf("unexpected '%s' in values of attribute %s", sep, attr.name)
+				}
+			}
+		default:
+			return fmt.Errorf("unknown type %s of attribute %s", attr.typ, attr.name)
+		}
+		switch tok := t.next(); tok {
+		case "#REQUIRED", "#IMPLIED":
+			attr.mode = tok
+		case "#FIXED":
+			attr.mode = tok
+			tok = t.next()
+			fallthrough
+		default:
+			if !isQuoted(tok) {
+				return fmt.Errorf("default of attribute %s missing", attr.name)
+			}
+			attr.value = replaceCharRefs(unquote(tok))
+		}
+		if findAttribute(p.dtd.attributes[element], attr.name) == nil {
+			// The first declaration is binding
+			p.dtd.attributes[element] = append(p.dtd.attributes[element], attr)
+		}
+	}
+}
+
+func findAttribute(attrs []attributeDecl, name string) *attributeDecl {
+	for idx := range attrs {
+		if attrs[idx].name == name {
+			return &attrs[idx]
+		}
+	}
+	return nil
+}
+
+// expand replaces parameter entity references outside of quoted literals by their values
+func (p *parser) expand(text string) (string, error) {
+	if !strings.Contains(text, "%") {
+		return text, nil
+	}
+	var b strings.Builder
+	s := &scanner{text: text}
+	var quote byte
+	for !s.eof() {
+		c := s.peek()
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '%':
+			if name, ok := s.reference(); ok {
+				value, _, err := p.entityText(name)
+				if err != nil {
+					return "", err
+				}
+				p.expanding[name] = true
+				value, err = p.expand(textDecl.ReplaceAllString(value, ""))
+				delete(p.expanding, name)
+				if err != nil {
+					return "", err
+				}
+				// Replacement text within declarations is padded by spaces
+				b.WriteString(" " + value + " ")
+				continue
+			}
+		}
+		b.WriteByte(c)
+		s.pos++
+	}
+	return b.String(), nil
+}
+
+var textDecl = regexp.MustCompile(`^\s*<\?xml[^?]*\?>`)
+
+// entityText returns value of parameter entity together with path relative references
+// within it are resolved against
+func (p *parser) entityText(name string) (string, string, error) {
+	e, found := p.entities[name]
+	if !found {
+		return "", "", fmt.Errorf("Undeclared parameter entity %%%s;", name)
+	}
+	if p.expanding[name] {
+		return "", "", fmt.Errorf("Parameter entity %%%s; refers to itself", name)
+	}
+	if e.system == "" {
+		return e.value, e.base, nil
+	}
+	if strings.Contains(e.system, "://") {
+		return "", "", fmt.Errorf("Cannot load parameter entity %%%s; from %s, only local files are supported", name, e.system)
+	}
+	entityPath := path.Join(path.Dir(e.base), e.system)
+	data, err := p.read(entityPath)
+	if err != nil {
+		return "", "", err
+	}
+	return string(data), entityPath, nil
+}
+
+var charRef = regexp.MustCompile(`&#(x[0-9a-fA-F]+|[0-9]+);`)
+
+// replaceCharRefs replaces character references and predefined entities of literal
+func replaceCharRefs(value string) string {
+	value = charRef.ReplaceAllStringFunc(value, func(ref string) string {
+		digits := ref[2 : len(ref)-1]
+		base := 10
+		if digits[0] == 'x' {
+			digits, base = digits[1:], 16
+		}
+		code, err := strconv.ParseInt(digits, base, 32)
+		if err != nil {
+			return ref
+		}
+		return string(rune(code))
+	})
+	return strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&", "&quot;", `"`, "&apos;", "'").Replace(value)
+}
+
+func abbreviate(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if len(text) > 40 {
+		return text[:40] + "..."
+	}
+	return text
+}
@@ -0,0 +1,173 @@
+package dtd
+
+import (
+	"strings"
+)
+
+// scanner walks markup declarations of DTD
+type scanner struct {
+	text string
+	pos  int
+}
+
+func (s *scanner) eof() bool {
+	return s.pos >= len(s.text)
+}
+
+func (s *scanner) peek() byte {
+	if s.eof() {
+		return 0
+	}
+	return s.text[s.pos]
+}
+
+func (s *scanner) rest() string {
+	return s.text[s.pos:]
+}
+
+func (s *scanner) consume(prefix string) bool {
+	if strings.HasPrefix(s.rest(), prefix) {
+		s.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (s *scanner) skipSpace() {
+	for !s.eof() && isSpace(s.peek()) {
+		s.pos++
+	}
+}
+
+// skipPast moves behind the next occurrence of end, reports whether there is one
+func (s *scanner) skipPast(end string) bool {
+	idx := strings.Index(s.rest(), end)
+	if idx == -1 {
+		s.pos = len(s.text)
+		return false
+	}
+	s.pos += idx + len(end)
+	return true
+}
+
+// declaration returns markup declaration up to its closing '>', which is skipped
+func (s *scanner) declaration() (string, bool) {
+	start := s.pos
+	var quote byte
+	for ; !s.eof(); s.pos++ {
+		c := s.peek()
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			s.pos++
+			return s.text[start : s.pos-1], true
+		}
+	}
+	return s.text[start:], false
+}
+
+// reference reads parameter entity reference %name; at current position
+func (s *scanner) reference() (string, bool) {
+	rest := s.rest()
+	end := strings.IndexByte(rest, ';')
+	if len(rest) < 2 || rest[0] != '%' || end < 2 || !isName(rest[1:end]) {
+		return "", false
+	}
+	s.pos += end + 1
+	return rest[1:end], true
+}
+
+// conditionalContent returns content of conditional section up to the matching ]]>, which
+// is skipped
+func (s *scanner) conditionalContent() (string, bool) {
+	start := s.pos
+	for depth := 1; !s.eof(); {
+		switch {
+		case s.consume("<!["):
+			depth++
+		case s.consume("]]>"):
+			depth--
+			if depth == 0 {
+				return s.text[start : s.pos-3], true
+			}
+		default:
+			s.pos++
+		}
+	}
+	return "", false
+}
+
+// tokenizer splits body of markup declaration into names, quoted literals and punctuation
+type tokenizer struct {
+	text string
+	pos  int
+}
+
+const punctuation = "()|,?*+%"
+
+// next returns the next token, empty string at the end of the declaration
+func (t *tokenizer) next() string {
+	for t.pos < len(t.text) && isSpace(t.text[t.pos]) {
+		t.pos++
+	}
+	if t.pos >= len(t.text) {
+		return ""
+	}
+	start := t.pos
+	c := t.text[t.pos]
+	switch {
+	case c == '"' || c == '\'':
+		end := strings.IndexByte(t.text[start+1:], c)
+		if end == -1 {
+			t.pos = len(t.text)
+		} else {
+			t.pos = start + end + 2
+		}
+	case strings.IndexByte(punctuation, c) != -1:
+		t.pos++
+	default:
+		for t.pos < len(t.text) && !isSpace(t.text[t.pos]) && strings.IndexByte(punctuation+`"'`, t.text[t.pos]) == -1 {
+			t.pos++
+		}
+	}
+	return t.text[start:t.pos]
+}
+
+func (t *tokenizer) peek() string {
+	pos := t.pos
+	defer func() { t.pos = pos }()
+	return t.next()
+}
+
+// occurrence reads optional ?, * or + following content particle
+func (t *tokenizer) occurrence() string {
+	switch tok := t.peek(); tok {
+	case "?", "*", "+":
+		return t.next()
+	}
+	return ""
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func isName(tok string) bool {
+	return tok != "" && !strings.ContainsAny(tok, punctuation+`"'#;&<> `+"\t\r\n")
+}
+
+func isQuoted(tok string) bool {
+	return len(tok) >= 2 && (tok[0] == '"' || tok[0] == '\'') && tok[len(tok)-1] == tok[0]
+}
+
+func unquote(tok string) string {
+	if isQuoted(tok) {
+		return tok[1 : len(tok)-1]
+	}
+	return tok
+}
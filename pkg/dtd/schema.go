@@ -0,0 +1,145 @@
+package dtd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Schema converts the DTD to schema without target namespace. Every declared element
+// becomes global xsd:element, content models refer to them. Attributes get XSD types of
+// the same name, enumerations become anonymous xsd:simpleType. Qualified names such as
+// xlink:href are reduced to their local part and xmlns attributes are left out.
+func (d *DTD) Schema() (*xsd.Schema, error) {
+	sch := &xsd.Schema{}
+	for _, decl := range d.elements {
+		el, err := d.convertElement(decl)
+		if err != nil {
+			return nil, err
+		}
+		sch.Elements = append(sch.Elements, el)
+	}
+	return sch, nil
+}
+
+func (d *DTD) convertElement(decl *elementDecl) (xsd.Element, error) {
+	el := xsd.Element{Name: localName(decl.name)}
+	attrs := d.convertAttributes(decl.name)
+	switch decl.content {
+	case contentEmpty:
+		el.ComplexType = &xsd.ComplexType{AttributesDirect: attrs}
+	case contentAny:
+		ct := &xsd.ComplexType{Mixed: true, Sequence: &xsd.Sequence{}, AttributesDirect: attrs}
+		ct.Sequence.Append(xsd.Any{MinOccurs: "0", MaxOccurs: "unbounded"})
+		el.ComplexType = ct
+	case contentMixed:
+		if len(decl.mixed) == 0 && len(attrs) == 0 {
+			el.Type = "xsd:string"
+			break
+		}
+		if len(decl.mixed) == 0 {
+			el.ComplexType = &xsd.ComplexType{SimpleContent: &xsd.SimpleContent{
+				Extension: &xsd.Extension{Base: "xsd:string", AttributesDirect: attrs},
+			}}
+			break
+		}
+		choice := &xsd.Choice{MinOccurs: "0", MaxOccurs: "unbounded"}
+		for _, name := range decl.mixed {
+			ref, err := d.convertParticle(decl, &particle{name: name})
+			if err != nil {
+				return el, err
+			}
+			choice.Append(ref)
+		}
+		el.ComplexType = &xsd.ComplexType{Mixed: true, Choice: choice, AttributesDirect: attrs}
+	case contentChildren:
+		model, err := d.convertParticle(decl, decl.model)
+		if err != nil {
+			return el, err
+		}
+		el.ComplexType = &xsd.ComplexType{AttributesDirect: attrs}
+		switch m := model.(type) {
+		case xsd.Choice:
+			el.ComplexType.Choice = &m
+		case xsd.Sequence:
+			el.ComplexType.Sequence = &m
+		}
+	}
+	return el, nil
+}
+
+// convertParticle returns xsd.Element referring to global element, xsd.Sequence or xsd.Choice
+func (d *DTD) convertParticle(decl *elementDecl, p *particle) (interface{}, error) {
+	minOccurs, maxOccurs := "", ""
+	switch p.occurs {
+	case "?":
+		minOccurs = "0"
+	case "*":
+		minOccurs, maxOccurs = "0", "unbounded"
+	case "+":
+		maxOccurs = "unbounded"
+	}
+	if p.group == 0 {
+		if d.element(p.name) == nil {
+			return nil, fmt.Errorf("Element %s declared in %s refers to undeclared element %s", decl.name, d.path, p.name)
+		}
+		return xsd.Element{Ref: xsd.Reference(localName(p.name)), MinOccurs: minOccurs, MaxOccurs: maxOccurs}, nil
+	}
+	var children []interface{}
+	for _, child := range p.children {
+		converted, err := d.convertParticle(decl, child)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, converted)
+	}
+	if p.group == '|' {
+		choice := xsd.Choice{MinOccurs: minOccurs, MaxOccurs: maxOccurs}
+		for _, child := range children {
+			choice.Append(child)
+		}
+		return choice, nil
+	}
+	seq := xsd.Sequence{MinOccurs: minOccurs, MaxOccurs: maxOccurs}
+	for _, child := range children {
+		seq.Append(child)
+	}
+	return seq, nil
+}
+
+func (d *DTD) convertAttributes(element string) []xsd.Attribute {
+	var res []xsd.Attribute
+	for _, decl := range d.attributes[element] {
+		if decl.name == "xmlns" || strings.HasPrefix(decl.name, "xmlns:") {
+			continue
+		}
+		attr := xsd.Attribute{Name: localName(decl.name), Use: "optional"}
+		switch decl.typ {
+		case "CDATA":
+			attr.Type = "xsd:string"
+		case "enumeration":
+			restriction := &xsd.Restriction{Base: "xsd:NMTOKEN"}
+			for _, value := range decl.values {
+				restriction.Enumerations = append(restriction.Enumerations, xsd.Enumeration{Value: value})
+			}
+			attr.SimpleType = &xsd.SimpleType{Restriction: restriction}
+		default:
+			attr.Type = "xsd:" + decl.typ
+		}
+		switch decl.mode {
+		case "#REQUIRED":
+			attr.Use = "required"
+		case "#FIXED":
+			attr.Fixed = decl.value
+		case "":
+			attr.Default = decl.value
+		}
+		res = append(res, attr)
+	}
+	return res
+}
+
+func localName(name string) string {
+	return name[strings.IndexByte(name, ':')+1:]
+}
@@ -0,0 +1,132 @@
+// Package fetch downloads remote XSD files into a content-addressed cache directory
+package fetch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cache is xsd.Resolver fetching http(s) schemas through a cache directory. Content is
+// stored in Dir/objects named by its sha256 digest, Dir/urls maps URLs to the digests.
+type Cache struct {
+	// Dir is the cache directory, see DefaultDir
+	Dir string
+	// Offline serves schemas only from the cache, never touching the network
+	Offline bool
+	// Refresh downloads schemas even if these are cached already
+	Refresh bool
+	// Client used for downloads, nil stands for http.DefaultClient
+	Client *http.Client
+}
+
+// DefaultDir is the cache directory used unless specified otherwise
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "xsd2go")
+}
+
+// Fetch returns content of the schema at given URL
+func (c *Cache) Fetch(url string) ([]byte, error) {
+	if !c.Refresh || c.Offline {
+		data, err := c.lookup(url)
+		if err == nil {
+			return data, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		if c.Offline {
+			return nil, fmt.Errorf("%s is not cached in '%s', run fetch command first or go online", url, c.Dir)
+		}
+	}
+
+	data, err := c.download(url)
+	if err != nil {
+		return nil, err
+	}
+	return data, c.store(url, data)
+}
+
+func (c *Cache) lookup(url string) ([]byte, error) {
+	digest, err := ioutil.ReadFile(c.urlPath(url))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(c.objectPath(strings.TrimSpace(string(digest))))
+	if err != nil {
+		return nil, err
+	}
+	if sha256Hex(data) != strings.TrimSpace(string(digest)) {
+		return nil, fmt.Errorf("Cached copy of %s in '%s' is corrupted", url, c.Dir)
+	}
+	return data, nil
+}
+
+func (c *Cache) download(url string) ([]byte, error) {
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Cannot fetch %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (c *Cache) store(url string, data []byte) error {
+	digest := sha256Hex(data)
+	for _, dir := range []string{filepath.Dir(c.objectPath(digest)), filepath.Dir(c.urlPath(url))} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	if err := writeFileAtomic(c.objectPath(digest), data); err != nil {
+		return err
+	}
+	return writeFileAtomic(c.urlPath(url), []byte(digest+"\n"))
+}
+
+func (c *Cache) objectPath(digest string) string {
+	return filepath.Join(c.Dir, "objects", digest+".xsd")
+}
+
+func (c *Cache) urlPath(url string) string {
+	return filepath.Join(c.Dir, "urls", sha256Hex([]byte(url)))
+}
+
+// writeFileAtomic makes sure concurrent runs never see partially written file
+func writeFileAtomic(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,449 @@
+// Package infer derives XSD schema from sample XML instance documents
+package infer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/iancoleman/strcase"
+)
+
+type Options struct {
+	// Prefix bound to the target namespace, defaults to the name of the root element
+	Prefix string
+	// MaxEnumValues is the maximum number of distinct values that makes text or
+	// attribute an enumeration. Zero disables enumeration inference.
+	MaxEnumValues int
+}
+
+// Inferrer accumulates observations from instance documents
+type Inferrer struct {
+	opts      Options
+	namespace string
+	nsSet     bool
+	roots     []string
+	elements  map[string]*elementInfo
+	order     []string
+}
+
+type elementInfo struct {
+	name      string
+	count     int
+	attrs     map[string]*valueInfo
+	attrOrder []string
+	children  []string
+	childMin  map[string]int
+	childMax  map[string]int
+	unordered bool
+	text      valueInfo
+	mixed     bool
+}
+
+// valueInfo tracks which simple types all observed values conform to
+type valueInfo struct {
+	count      int
+	values     map[string]int
+	notBool    bool
+	notInt     bool
+	notDecimal bool
+	notDate    bool
+	notTime    bool
+}
+
+type frame struct {
+	info   *elementInfo
+	counts map[string]int
+	runs   []string
+	text   strings.Builder
+}
+
+func New(opts Options) *Inferrer {
+	return &Inferrer{
+		opts:     opts,
+		elements: map[string]*elementInfo{},
+	}
+}
+
+// Add reads single XML instance document
+func (inf *Inferrer) Add(r io.Reader) error {
+	d := xml.NewDecoder(r)
+	var stack []*frame
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if err := inf.checkNamespace(t.Name); err != nil {
+				return err
+			}
+			name := t.Name.Local
+			if len(stack) == 0 {
+				inf.addRoot(name)
+			} else {
+				parent := stack[len(stack)-1]
+				if len(parent.runs) == 0 || parent.runs[len(parent.runs)-1] != name {
+					parent.runs = append(parent.runs, name)
+				}
+				parent.counts[name]++
+			}
+			info := inf.element(name)
+			info.count++
+			for _, attr := range t.Attr {
+				if attr.Name.Space != "" || attr.Name.Local == "xmlns" {
+					// xmlns declarations and attributes of foreign namespaces (xml:, xsi:)
+					continue
+				}
+				info.attribute(attr.Name.Local).add(attr.Value)
+			}
+			stack = append(stack, &frame{info: info, counts: map[string]int{}})
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		case xml.EndElement:
+			f := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			f.info.finish(f)
+		}
+	}
+	return nil
+}
+
+func (inf *Inferrer) checkNamespace(name xml.Name) error {
+	if !inf.nsSet {
+		inf.namespace = name.Space
+		inf.nsSet = true
+	} else if name.Space != inf.namespace {
+		return fmt.Errorf("Element %s is in namespace '%s', only single namespace ('%s') is supported",
+			name.Local, name.Space, inf.namespace)
+	}
+	return nil
+}
+
+func (inf *Inferrer) addRoot(name string) {
+	for _, root := range inf.roots {
+		if root == name {
+			return
+		}
+	}
+	inf.roots = append(inf.roots, name)
+}
+
+func (inf *Inferrer) element(name string) *elementInfo {
+	info, found := inf.elements[name]
+	if !found {
+		info = &elementInfo{
+			name:     name,
+			attrs:    map[string]*valueInfo{},
+			childMin: map[string]int{},
+			childMax: map[string]int{},
+		}
+		inf.elements[name] = info
+		inf.order = append(inf.order, name)
+	}
+	return info
+}
+
+func (info *elementInfo) attribute(name string) *valueInfo {
+	v, found := info.attrs[name]
+	if !found {
+		v = &valueInfo{}
+		info.attrs[name] = v
+		info.attrOrder = append(info.attrOrder, name)
+	}
+	return v
+}
+
+func (info *elementInfo) finish(f *frame) {
+	text := strings.TrimSpace(f.text.String())
+	if text != "" {
+		info.text.add(text)
+		if len(f.runs) > 0 {
+			info.mixed = true
+		}
+	} else if len(f.runs) == 0 {
+		info.text.addEmpty()
+	}
+
+	// Merge order of children with the order seen so far
+	seen := map[string]bool{}
+	pos := 0
+	for _, name := range f.runs {
+		if seen[name] {
+			// Same child appears in multiple non-adjacent runs
+			info.unordered = true
+			continue
+		}
+		seen[name] = true
+		idx := indexOf(info.children, name)
+		if idx == -1 {
+			info.children = append(info.children[:pos], append([]string{name}, info.children[pos:]...)...)
+			idx = pos
+		} else if idx < pos {
+			info.unordered = true
+			continue
+		}
+		pos = idx + 1
+	}
+
+	for name, count := range f.counts {
+		min, found := info.childMin[name]
+		if !found {
+			if info.count == 1 {
+				min = count
+			}
+		} else if count < min {
+			min = count
+		}
+		info.childMin[name] = min
+		if count > info.childMax[name] {
+			info.childMax[name] = count
+		}
+	}
+	for _, name := range info.children {
+		if f.counts[name] == 0 {
+			info.childMin[name] = 0
+		}
+	}
+}
+
+func (v *valueInfo) add(value string) {
+	v.count++
+	if v.values == nil {
+		v.values = map[string]int{}
+	}
+	v.values[value]++
+
+	if value != "true" && value != "false" {
+		v.notBool = true
+	}
+	if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+		v.notInt = true
+	}
+	if _, err := strconv.ParseFloat(value, 64); err != nil || strings.ContainsAny(value, "xXpPiInN") {
+		v.notDecimal = true
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		v.notDate = true
+	}
+	if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+		if _, err := time.Parse("2006-01-02T15:04:05", value); err != nil {
+			v.notTime = true
+		}
+	}
+}
+
+// addEmpty records element without text, such value conforms to string only. It does not
+// count as text content, elements that are always empty get no simple content.
+func (v *valueInfo) addEmpty() {
+	if v.values == nil {
+		v.values = map[string]int{}
+	}
+	v.values[""]++
+	v.notBool, v.notInt, v.notDecimal, v.notDate, v.notTime = true, true, true, true, true
+}
+
+// builtinType returns the most specific built-in XSD type matching all values
+func (v *valueInfo) builtinType() string {
+	switch {
+	case v.count == 0:
+		return "string"
+	case !v.notBool:
+		return "boolean"
+	case !v.notInt:
+		return "integer"
+	case !v.notDecimal:
+		return "decimal"
+	case !v.notDate:
+		return "date"
+	case !v.notTime:
+		return "dateTime"
+	}
+	return "string"
+}
+
+// isEnum decides whether the observed values look like closed set of tokens
+func (v *valueInfo) isEnum(maxValues int) bool {
+	if v.builtinType() != "string" || len(v.values) > maxValues || v.count < 2*len(v.values) {
+		return false
+	}
+	for value := range v.values {
+		if value == "" || strings.ContainsAny(value, " \t\r\n") {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func indexOf(list []string, item string) int {
+	for idx, i := range list {
+		if i == item {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Schema builds the XSD out of all documents added so far
+func (inf *Inferrer) Schema() (*xsd.Schema, error) {
+	if len(inf.roots) == 0 {
+		return nil, fmt.Errorf("No XML documents to infer schema from")
+	}
+	prefix := inf.opts.Prefix
+	if prefix == "" {
+		prefix = strcase.ToSnake(inf.roots[0])
+	}
+	b := builder{
+		inf:       inf,
+		prefix:    prefix,
+		typeNames: map[string]bool{},
+		types:     map[string]xsd.Reference{},
+		schema: &xsd.Schema{
+			TargetNamespace: inf.namespace,
+		},
+	}
+	if inf.namespace != "" {
+		b.schema.ElementFormDefault = "qualified"
+		b.schema.Xmlns = xsd.Xmlns{{Prefix: prefix, Uri: inf.namespace}}
+	}
+	for _, root := range inf.roots {
+		b.schema.Elements = append(b.schema.Elements, xsd.Element{
+			Name: root,
+			Type: b.typeOf(inf.elements[root]),
+		})
+	}
+	return b.schema, nil
+}
+
+type builder struct {
+	inf       *Inferrer
+	prefix    string
+	schema    *xsd.Schema
+	typeNames map[string]bool
+	types     map[string]xsd.Reference
+}
+
+func (b *builder) qualify(name string) xsd.Reference {
+	if b.inf.namespace == "" {
+		return xsd.Reference(name)
+	}
+	return xsd.Reference(b.prefix + ":" + name)
+}
+
+func (b *builder) newTypeName(base string) string {
+	name := strcase.ToCamel(base) + "Type"
+	for i := 2; b.typeNames[name]; i++ {
+		name = fmt.Sprintf("%s%dType", strcase.ToCamel(base), i)
+	}
+	b.typeNames[name] = true
+	return name
+}
+
+// simpleType returns reference to built-in type or to newly declared enumeration
+func (b *builder) simpleType(v *valueInfo, name string) xsd.Reference {
+	if b.inf.opts.MaxEnumValues == 0 || !v.isEnum(b.inf.opts.MaxEnumValues) {
+		return xsd.Reference("xsd:" + v.builtinType())
+	}
+	restriction := xsd.Restriction{Base: "xsd:string"}
+	for _, value := range sortedKeys(v.values) {
+		restriction.Enumerations = append(restriction.Enumerations, xsd.Enumeration{Value: value})
+	}
+	typeName := b.newTypeName(name)
+	b.schema.SimpleTypes = append(b.schema.SimpleTypes, xsd.SimpleType{
+		Name:        typeName,
+		Restriction: &restriction,
+	})
+	return b.qualify(typeName)
+}
+
+func (b *builder) typeOf(info *elementInfo) xsd.Reference {
+	if ref, found := b.types[info.name]; found {
+		return ref
+	}
+	if len(info.children) == 0 && len(info.attrs) == 0 && info.text.count > 0 {
+		ref := b.simpleType(&info.text, info.name)
+		b.types[info.name] = ref
+		return ref
+	}
+
+	typeName := b.newTypeName(info.name)
+	ref := b.qualify(typeName)
+	b.types[info.name] = ref
+	idx := len(b.schema.ComplexTypes)
+	b.schema.ComplexTypes = append(b.schema.ComplexTypes, xsd.ComplexType{})
+
+	ct := xsd.ComplexType{Name: typeName, Mixed: info.mixed}
+	var attrs []xsd.Attribute
+	for _, name := range info.attrOrder {
+		v := info.attrs[name]
+		attr := xsd.Attribute{
+			Name: name,
+			Type: string(b.simpleType(v, info.name+"-"+name)),
+		}
+		if v.count == info.count {
+			attr.Use = "required"
+		}
+		attrs = append(attrs, attr)
+	}
+
+	if len(info.children) == 0 {
+		if info.text.count > 0 {
+			ct.SimpleContent = &xsd.SimpleContent{
+				Extension: &xsd.Extension{
+					Base:             b.simpleType(&info.text, info.name),
+					AttributesDirect: attrs,
+				},
+			}
+		} else {
+			ct.AttributesDirect = attrs
+		}
+	} else {
+		ct.AttributesDirect = attrs
+		var elements []xsd.Element
+		for _, name := range info.children {
+			el := xsd.Element{
+				Name: name,
+				Type: b.typeOf(b.inf.elements[name]),
+			}
+			if info.unordered {
+				elements = append(elements, el)
+				continue
+			}
+			if info.childMin[name] == 0 {
+				el.MinOccurs = "0"
+			}
+			if info.childMax[name] > 1 {
+				el.MaxOccurs = "unbounded"
+			}
+			elements = append(elements, el)
+		}
+		if info.unordered {
+			// Children interleave in different orders, any order and count is allowed
+			ct.Choice = &xsd.Choice{MinOccurs: "0", MaxOccurs: "unbounded", Elements: elements}
+		} else {
+			ct.Sequence = &xsd.Sequence{ElementList: elements}
+		}
+	}
+	b.schema.ComplexTypes[idx] = ct
+	return ref
+}
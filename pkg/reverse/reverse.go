@@ -0,0 +1,405 @@
+// Package reverse builds XSD schema out of go struct declarations annotated with xml struct tags
+package reverse
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+type Options struct {
+	// TargetNamespace of the resulting schema
+	TargetNamespace string
+	// Prefix bound to the target namespace, defaults to the go package name
+	Prefix string
+}
+
+var basicTypes = map[string]string{
+	"string":  "string",
+	"bool":    "boolean",
+	"int":     "long",
+	"int8":    "byte",
+	"int16":   "short",
+	"int32":   "int",
+	"int64":   "long",
+	"uint":    "unsignedLong",
+	"uint8":   "unsignedByte",
+	"byte":    "unsignedByte",
+	"uint16":  "unsignedShort",
+	"uint32":  "unsignedInt",
+	"uint64":  "unsignedLong",
+	"float32": "float",
+	"float64": "double",
+}
+
+type pkg struct {
+	name string
+	// order lists types declared in the package in declaration order
+	order []*types.TypeName
+	// docs maps declared types and struct fields to their doc comments
+	docs map[types.Object]string
+	// complexTypes maps names of emitted xsd:complexTypes to go types they come from
+	complexTypes map[string]*types.TypeName
+	opts         Options
+}
+
+// Schema loads go package from given directory and converts its structs to XSD.
+// Structs with XMLName field become top-level xsd:elements. Struct types of other
+// packages the structs refer to are converted as well.
+func Schema(pkgDir string, opts Options) (*xsd.Schema, error) {
+	p, err := loadPackage(pkgDir)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = p.name
+	}
+	p.opts = opts
+
+	sch := xsd.Schema{
+		TargetNamespace: opts.TargetNamespace,
+	}
+	if opts.TargetNamespace != "" {
+		sch.ElementFormDefault = "qualified"
+		sch.Xmlns = xsd.Xmlns{{Prefix: opts.Prefix, Uri: opts.TargetNamespace}}
+	}
+
+	emitted := map[*types.TypeName]bool{}
+	pending := []*types.TypeName{}
+	for _, obj := range p.order {
+		if obj.Exported() {
+			pending = append(pending, obj)
+		}
+	}
+	for len(pending) > 0 {
+		obj := pending[0]
+		pending = pending[1:]
+		if emitted[obj] {
+			continue
+		}
+		emitted[obj] = true
+
+		st, ok := obj.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		if other, found := p.complexTypes[obj.Name()]; found {
+			return nil, fmt.Errorf("Both %s and %s would become xsd:complexType %s", qualifiedName(other), qualifiedName(obj), obj.Name())
+		}
+		p.complexTypes[obj.Name()] = obj
+		ct, rootName, deps, err := p.complexType(obj, st)
+		if err != nil {
+			return nil, err
+		}
+		sch.ComplexTypes = append(sch.ComplexTypes, *ct)
+		pending = append(pending, deps...)
+		if rootName != "" {
+			sch.Elements = append(sch.Elements, xsd.Element{
+				Name: rootName,
+				Type: p.qualify(obj.Name()),
+			})
+		}
+	}
+	if len(sch.ComplexTypes) == 0 {
+		return nil, fmt.Errorf("No structs found in go package at '%s'", pkgDir)
+	}
+	return &sch, nil
+}
+
+// loadPackage parses and type-checks go package, packages it imports are type-checked
+// from their sources
+func loadPackage(pkgDir string) (*pkg, error) {
+	bp, err := build.ImportDir(pkgDir, 0)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, fileName := range bp.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(pkgDir, fileName), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	info := types.Info{Defs: map[*ast.Ident]types.Object{}}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check(bp.ImportPath, fset, files, &info); err != nil {
+		return nil, err
+	}
+
+	p := pkg{
+		name:         bp.Name,
+		docs:         map[types.Object]string{},
+		complexTypes: map[string]*types.TypeName{},
+	}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				obj, ok := info.Defs[ts.Name].(*types.TypeName)
+				if !ok {
+					continue
+				}
+				p.order = append(p.order, obj)
+				doc := ts.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+				p.docs[obj] = doc.Text()
+			}
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if field, ok := n.(*ast.Field); ok && field.Doc != nil {
+				for _, name := range field.Names {
+					if obj := info.Defs[name]; obj != nil {
+						p.docs[obj] = field.Doc.Text()
+					}
+				}
+			}
+			return true
+		})
+	}
+	return &p, nil
+}
+
+func (p *pkg) qualify(name string) xsd.Reference {
+	if p.opts.TargetNamespace == "" {
+		return xsd.Reference(name)
+	}
+	return xsd.Reference(p.opts.Prefix + ":" + name)
+}
+
+// structField is field of go struct with its xml struct tag
+type structField struct {
+	*types.Var
+	tag string
+}
+
+// complexType converts struct to xsd:complexType. Returns xsd:element name when the struct
+// defines XMLName and types the struct depends on.
+func (p *pkg) complexType(obj *types.TypeName, st *types.Struct) (*xsd.ComplexType, string, []*types.TypeName, error) {
+	name := obj.Name()
+	ct := xsd.ComplexType{
+		Name:       name,
+		Annotation: annotation(p.docs[obj]),
+	}
+	var rootName, textType string
+	var elements []xsd.Element
+	var deps []*types.TypeName
+
+	fields, err := flattenFields(st, map[*types.TypeName]bool{obj: true})
+	if err != nil {
+		return nil, "", nil, err
+	}
+	for _, field := range fields {
+		fieldName := field.Name()
+		tagName, flags := parseTag(field.tag)
+		if fieldName == "XMLName" {
+			rootName = tagName
+			if rootName == "" {
+				rootName = name
+			}
+			continue
+		}
+		if !field.Exported() || tagName == "-" {
+			continue
+		}
+		if tagName == "" {
+			tagName = fieldName
+		}
+
+		t := analyzeType(field.Type())
+		if t.err != nil {
+			return nil, "", nil, fmt.Errorf("%s.%s: %s", name, fieldName, t.err)
+		}
+		switch {
+		case flags["attr"]:
+			if t.local != nil || t.array {
+				return nil, "", nil, fmt.Errorf("%s.%s: xml attribute must be of simple type", name, fieldName)
+			}
+			attr := xsd.Attribute{
+				Name:       tagName,
+				Type:       string(t.ref),
+				Annotation: annotation(p.docs[field.Var]),
+			}
+			if !t.optional && !flags["omitempty"] {
+				attr.Use = "required"
+			}
+			ct.AttributesDirect = append(ct.AttributesDirect, attr)
+		case flags["chardata"]:
+			textType = string(t.ref)
+		case flags["innerxml"]:
+			ct.Mixed = true
+		case flags["comment"] || flags["any"]:
+			continue
+		default:
+			if strings.Contains(tagName, ">") {
+				return nil, "", nil, fmt.Errorf("%s.%s: nested xml paths (a>b) are not supported", name, fieldName)
+			}
+			el := xsd.Element{
+				Name:       tagName,
+				Type:       t.ref,
+				Annotation: annotation(p.docs[field.Var]),
+			}
+			if t.local != nil {
+				el.Type = p.qualify(t.local.Name())
+				deps = append(deps, t.local)
+			}
+			if t.optional || t.array || flags["omitempty"] {
+				el.MinOccurs = "0"
+			}
+			if t.array {
+				el.MaxOccurs = "unbounded"
+			}
+			elements = append(elements, el)
+		}
+	}
+
+	if textType != "" && len(elements) == 0 {
+		ct.SimpleContent = &xsd.SimpleContent{
+			Extension: &xsd.Extension{
+				Base:             xsd.Reference(textType),
+				AttributesDirect: ct.AttributesDirect,
+			},
+		}
+		ct.AttributesDirect = nil
+	} else {
+		if textType != "" {
+			ct.Mixed = true
+		}
+		if len(elements) > 0 {
+			ct.Sequence = &xsd.Sequence{ElementList: elements}
+		}
+	}
+	return &ct, rootName, deps, nil
+}
+
+// flattenFields inlines fields of embedded structs, the way encoding/xml does
+func flattenFields(st *types.Struct, seen map[*types.TypeName]bool) ([]structField, error) {
+	var res []structField
+	for idx := 0; idx < st.NumFields(); idx++ {
+		field := st.Field(idx)
+		if !field.Embedded() {
+			res = append(res, structField{Var: field, tag: st.Tag(idx)})
+			continue
+		}
+		typ := field.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		named, ok := typ.(*types.Named)
+		if !ok {
+			return nil, fmt.Errorf("Embedded field of type %s not supported", typeString(field.Type()))
+		}
+		inner, ok := named.Underlying().(*types.Struct)
+		if !ok {
+			return nil, fmt.Errorf("Embedded field of type %s not supported", typeString(named))
+		}
+		if seen[named.Obj()] {
+			continue
+		}
+		seen[named.Obj()] = true
+		innerFields, err := flattenFields(inner, seen)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, innerFields...)
+	}
+	return res, nil
+}
+
+type fieldType struct {
+	ref xsd.Reference
+	// local is struct type converted to xsd:complexType the field refers to
+	local    *types.TypeName
+	optional bool
+	array    bool
+	err      error
+}
+
+func analyzeType(typ types.Type) fieldType {
+	switch t := typ.(type) {
+	case *types.Pointer:
+		res := analyzeType(t.Elem())
+		res.optional = true
+		return res
+	case *types.Slice:
+		return analyzeArray(t.Elem())
+	case *types.Array:
+		return analyzeArray(t.Elem())
+	case *types.Basic:
+		if xsdType, found := basicTypes[t.Name()]; found {
+			return fieldType{ref: xsd.Reference("xsd:" + xsdType)}
+		}
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return fieldType{ref: "xsd:dateTime"}
+		}
+		if _, ok := t.Underlying().(*types.Struct); ok {
+			return fieldType{local: obj}
+		}
+		// Named type such as `type Status string`
+		return analyzeType(t.Underlying())
+	}
+	return fieldType{err: fmt.Errorf("unsupported type %s", typeString(typ))}
+}
+
+func analyzeArray(elem types.Type) fieldType {
+	if basic, ok := elem.(*types.Basic); ok && basic.Kind() == types.Byte {
+		return fieldType{ref: "xsd:base64Binary"}
+	}
+	res := analyzeType(elem)
+	if res.array {
+		res.err = fmt.Errorf("nested arrays are not supported")
+	}
+	res.array = true
+	return res
+}
+
+func parseTag(tag string) (string, map[string]bool) {
+	flags := map[string]bool{}
+	parts := strings.Split(reflect.StructTag(tag).Get("xml"), ",")
+	for _, flag := range parts[1:] {
+		flags[flag] = true
+	}
+	// Drop namespace from "namespace-URL name" form
+	name := parts[0]
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		name = name[i+1:]
+	}
+	return name, flags
+}
+
+func annotation(doc string) *xsd.Annotation {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return nil
+	}
+	return &xsd.Annotation{Documentations: []xsd.Documentation{{Text: doc}}}
+}
+
+// typeString formats type the way it is written in go source
+func typeString(typ types.Type) string {
+	return types.TypeString(typ, (*types.Package).Name)
+}
+
+// qualifiedName formats type name with the package it is declared in
+func qualifiedName(obj *types.TypeName) string {
+	return typeString(obj.Type())
+}
@@ -0,0 +1,576 @@
+package rng
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Token kinds of compact syntax
+const (
+	tokenEOF        = 0
+	tokenIdentifier = 'i'
+	tokenLiteral    = 'l'
+	tokenOperator   = 'o'
+)
+
+type token struct {
+	kind byte
+	text string
+	// escaped identifiers (such as \element) are never keywords
+	escaped bool
+	line    int
+}
+
+var compactKeywords = map[string]bool{
+	"attribute": true, "default": true, "datatypes": true, "div": true, "element": true,
+	"empty": true, "external": true, "grammar": true, "include": true, "inherit": true,
+	"list": true, "mixed": true, "namespace": true, "notAllowed": true, "parent": true,
+	"start": true, "string": true, "text": true, "token": true,
+}
+
+func (t token) is(kind byte, text string) bool {
+	return t.kind == kind && t.text == text && !t.escaped
+}
+
+func (t token) keyword(text string) bool {
+	return t.is(tokenIdentifier, text)
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of file"
+	}
+	return "'" + t.text + "'"
+}
+
+// lexCompact splits grammar in compact syntax to tokens, comments and annotations are left out
+func lexCompact(text string) ([]token, error) {
+	var res []token
+	line := 1
+	for pos := 0; pos < len(text); {
+		c := text[pos]
+		switch {
+		case c == '\n':
+			line++
+			pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			pos++
+		case c == '#':
+			for pos < len(text) && text[pos] != '\n' {
+				pos++
+			}
+		case c == '[':
+			end, err := skipAnnotation(text, pos)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line, err)
+			}
+			line += strings.Count(text[pos:end], "\n")
+			pos = end
+		case strings.HasPrefix(text[pos:], ">>"):
+			// Following annotation element: >> name [ ... ]
+			pos += 2
+		case c == '"' || c == '\'':
+			value, end, err := lexLiteral(text, pos)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line, err)
+			}
+			res = append(res, token{kind: tokenLiteral, text: value, line: line})
+			line += strings.Count(text[pos:end], "\n")
+			pos = end
+		case strings.HasPrefix(text[pos:], "|=") || strings.HasPrefix(text[pos:], "&="):
+			res = append(res, token{kind: tokenOperator, text: text[pos : pos+2], line: line})
+			pos += 2
+		case strings.IndexByte("=,|&?*+-(){}~", c) != -1:
+			res = append(res, token{kind: tokenOperator, text: string(c), line: line})
+			pos++
+		default:
+			escaped := c == '\\'
+			if escaped {
+				pos++
+			}
+			end := pos
+			for end < len(text) && isNameChar(text, end) {
+				end++
+			}
+			// prefix:name and prefix:* are single tokens
+			if end < len(text)-1 && text[end] == ':' && (text[end+1] == '*' || isNameChar(text, end+1)) {
+				end++
+				if text[end] == '*' {
+					end++
+				} else {
+					for end < len(text) && isNameChar(text, end) {
+						end++
+					}
+				}
+			}
+			if end == pos {
+				return nil, fmt.Errorf("line %d: unexpected character '%c'", line, c)
+			}
+			res = append(res, token{kind: tokenIdentifier, text: text[pos:end], escaped: escaped, line: line})
+			pos = end
+		}
+	}
+	return append(res, token{kind: tokenEOF, line: line}), nil
+}
+
+func isNameChar(text string, pos int) bool {
+	c := rune(text[pos])
+	return c == '_' || c == '-' || c == '.' || unicode.IsLetter(c) || unicode.IsDigit(c) || c >= 0x80
+}
+
+func lexLiteral(text string, pos int) (string, int, error) {
+	quote := text[pos : pos+1]
+	if strings.HasPrefix(text[pos:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	end := strings.Index(text[pos+len(quote):], quote)
+	if end == -1 {
+		return "", 0, fmt.Errorf("unterminated literal")
+	}
+	start := pos + len(quote)
+	return text[start : start+end], start + end + len(quote), nil
+}
+
+// skipAnnotation returns position behind annotation starting by '[' at pos
+func skipAnnotation(text string, pos int) (int, error) {
+	depth := 0
+	for pos < len(text) {
+		switch text[pos] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return pos + 1, nil
+			}
+		case '"', '\'':
+			_, end, err := lexLiteral(text, pos)
+			if err != nil {
+				return 0, err
+			}
+			pos = end
+			continue
+		}
+		pos++
+	}
+	return 0, fmt.Errorf("unterminated annotation")
+}
+
+// compactParser parses grammar in compact syntax by recursive descent
+type compactParser struct {
+	l      *loader
+	g      *Grammar
+	path   string
+	tokens []token
+	pos    int
+	// defaultNs is namespace of unprefixed element names
+	defaultNs string
+	xmlns     map[string]string
+	datatypes map[string]string
+}
+
+func (l *loader) parseCompact(g *Grammar, filePath, text string) ([]component, error) {
+	tokens, err := lexCompact(text)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing RELAX NG grammar %s: %s", filePath, err)
+	}
+	p := &compactParser{
+		l: l, g: g, path: filePath, tokens: tokens,
+		xmlns:     map[string]string{"xml": xmlNamespace},
+		datatypes: map[string]string{"xsd": XSDDatatypes},
+	}
+	components, err := p.topLevel()
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing RELAX NG grammar %s: line %d: %s", filePath, p.peek().line, err)
+	}
+	return components, nil
+}
+
+func (p *compactParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *compactParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *compactParser) expect(text string) error {
+	if t := p.next(); !t.is(tokenOperator, text) {
+		return fmt.Errorf("expected '%s', found %s", text, t)
+	}
+	return nil
+}
+
+func (p *compactParser) literal() (string, error) {
+	t := p.next()
+	if t.kind != tokenLiteral {
+		return "", fmt.Errorf("expected literal, found %s", t)
+	}
+	value := t.text
+	for p.peek().is(tokenOperator, "~") {
+		p.next()
+		more := p.next()
+		if more.kind != tokenLiteral {
+			return "", fmt.Errorf("expected literal, found %s", more)
+		}
+		value += more.text
+	}
+	return value, nil
+}
+
+func (p *compactParser) topLevel() ([]component, error) {
+	if err := p.declarations(); err != nil {
+		return nil, err
+	}
+	if p.peek().keyword("grammar") {
+		p.next()
+		if err := p.expect("{"); err != nil {
+			return nil, err
+		}
+		components, err := p.grammarContent("}")
+		if err != nil {
+			return nil, err
+		}
+		return components, p.expect("}")
+	}
+	if p.isGrammarContent() {
+		return p.grammarContent("")
+	}
+	start, err := p.pattern()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s", t)
+	}
+	return []component{{pattern: start}}, nil
+}
+
+// declarations reads namespace and datatypes declarations preceding the grammar
+func (p *compactParser) declarations() error {
+	for {
+		t := p.peek()
+		isDefault := t.keyword("default")
+		if !isDefault && !t.keyword("namespace") && !t.keyword("datatypes") {
+			return nil
+		}
+		p.next()
+		if isDefault {
+			if t := p.next(); !t.keyword("namespace") {
+				return fmt.Errorf("expected 'namespace', found %s", t)
+			}
+		}
+		prefix := ""
+		if t := p.peek(); t.kind == tokenIdentifier {
+			prefix = p.next().text
+		}
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		var uri string
+		if p.peek().keyword("inherit") {
+			p.next()
+		} else {
+			var err error
+			if uri, err = p.literal(); err != nil {
+				return err
+			}
+		}
+		switch {
+		case t.keyword("datatypes"):
+			p.datatypes[prefix] = uri
+		default:
+			if isDefault {
+				p.defaultNs = uri
+			}
+			if prefix != "" {
+				p.xmlns[prefix] = uri
+				if _, found := p.g.xmlns[prefix]; !found {
+					p.g.xmlns[prefix] = uri
+				}
+			}
+		}
+	}
+}
+
+func (p *compactParser) isGrammarContent() bool {
+	t := p.peek()
+	if t.keyword("start") || t.keyword("div") || t.keyword("include") {
+		return true
+	}
+	if t.kind != tokenIdentifier || (compactKeywords[t.text] && !t.escaped) {
+		return false
+	}
+	following := p.tokens[p.pos+1]
+	return following.is(tokenOperator, "=") || following.is(tokenOperator, "|=") || following.is(tokenOperator, "&=")
+}
+
+// grammarContent reads start, defines, div and include up to the closing token
+func (p *compactParser) grammarContent(closing string) ([]component, error) {
+	var res []component
+	for {
+		t := p.peek()
+		if (closing == "" && t.kind == tokenEOF) || t.is(tokenOperator, closing) {
+			return res, nil
+		}
+		p.next()
+		switch {
+		case t.keyword("div"):
+			if err := p.expect("{"); err != nil {
+				return nil, err
+			}
+			components, err := p.grammarContent("}")
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, components...)
+			p.next()
+		case t.keyword("include"):
+			href, err := p.literal()
+			if err != nil {
+				return nil, err
+			}
+			if p.peek().keyword("inherit") {
+				p.next()
+				p.next()
+				p.next()
+			}
+			var overriding []component
+			if p.peek().is(tokenOperator, "{") {
+				p.next()
+				if overriding, err = p.grammarContent("}"); err != nil {
+					return nil, err
+				}
+				p.next()
+			}
+			if err := p.include(href, overriding); err != nil {
+				return nil, err
+			}
+			res = append(res, overriding...)
+		case t.keyword("start") || (t.kind == tokenIdentifier && (!compactKeywords[t.text] || t.escaped)):
+			c := component{}
+			if !t.keyword("start") {
+				c.name = t.text
+			}
+			switch op := p.next(); {
+			case op.is(tokenOperator, "|="):
+				c.combine = combineChoice
+			case op.is(tokenOperator, "&="):
+				c.combine = combineInterl
+			case !op.is(tokenOperator, "="):
+				return nil, fmt.Errorf("expected '=', found %s", op)
+			}
+			pat, err := p.pattern()
+			if err != nil {
+				return nil, err
+			}
+			c.pattern = pat
+			res = append(res, c)
+		default:
+			return nil, fmt.Errorf("unexpected %s in grammar", t)
+		}
+	}
+}
+
+func (p *compactParser) include(href string, overriding []component) error {
+	includePath, err := resolvePath(p.path, href)
+	if err != nil {
+		return err
+	}
+	overrides := map[string]bool{}
+	for _, c := range overriding {
+		overrides[c.name] = true
+	}
+	return p.l.load(p.g, includePath, overrides)
+}
+
+// pattern reads particles joined by one of ',', '|' or '&'
+func (p *compactParser) pattern() (*pattern, error) {
+	first, err := p.particle()
+	if err != nil {
+		return nil, err
+	}
+	children := []*pattern{first}
+	var op string
+	for {
+		t := p.peek()
+		if !t.is(tokenOperator, ",") && !t.is(tokenOperator, "|") && !t.is(tokenOperator, "&") {
+			break
+		}
+		if op != "" && op != t.text {
+			return nil, fmt.Errorf("operators '%s' and '%s' mixed without parentheses", op, t.text)
+		}
+		op = t.text
+		p.next()
+		child, err := p.particle()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	kind := map[string]string{",": kindGroup, "|": kindChoice, "&": kindInterleave, "": kindGroup}[op]
+	return group(kind, children), nil
+}
+
+func (p *compactParser) particle() (*pattern, error) {
+	primary, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []struct{ text, kind string }{{"?", kindOptional}, {"*", kindZeroOrMore}, {"+", kindOneOrMore}} {
+		if p.peek().is(tokenOperator, op.text) {
+			p.next()
+			return &pattern{kind: op.kind, children: []*pattern{primary}}, nil
+		}
+	}
+	return primary, nil
+}
+
+func (p *compactParser) primary() (*pattern, error) {
+	t := p.next()
+	switch {
+	case t.keyword("element"), t.keyword("attribute"):
+		name, err := p.nameClass(t.text == kindElement)
+		if err != nil {
+			return nil, err
+		}
+		content, err := p.braced()
+		if err != nil {
+			return nil, err
+		}
+		return &pattern{kind: t.text, name: name, children: []*pattern{content}}, nil
+	case t.keyword("mixed"), t.keyword("list"):
+		content, err := p.braced()
+		if err != nil {
+			return nil, err
+		}
+		return &pattern{kind: t.text, children: []*pattern{content}}, nil
+	case t.keyword("empty"), t.keyword("text"), t.keyword("notAllowed"):
+		return &pattern{kind: t.text}, nil
+	case t.keyword("string"), t.keyword("token"):
+		return p.datatype(t.text, "")
+	case t.keyword("external"):
+		href, err := p.literal()
+		if err != nil {
+			return nil, err
+		}
+		externalPath, err := resolvePath(p.path, href)
+		if err != nil {
+			return nil, err
+		}
+		return p.l.external(p.g, externalPath)
+	case t.keyword("parent"), t.keyword("grammar"):
+		return nil, fmt.Errorf("RELAX NG %s is not supported", t.text)
+	case t.kind == tokenLiteral:
+		p.pos--
+		value, err := p.literal()
+		if err != nil {
+			return nil, err
+		}
+		return &pattern{kind: kindValue, datatype: "token", value: value}, nil
+	case t.is(tokenOperator, "("):
+		content, err := p.pattern()
+		if err != nil {
+			return nil, err
+		}
+		return content, p.expect(")")
+	case t.kind == tokenIdentifier && strings.Contains(t.text, ":"):
+		idx := strings.IndexByte(t.text, ':')
+		library, found := p.datatypes[t.text[:idx]]
+		if !found {
+			return nil, fmt.Errorf("undeclared datatypes prefix %s", t.text[:idx])
+		}
+		return p.datatype(t.text[idx+1:], library)
+	case t.kind == tokenIdentifier && (!compactKeywords[t.text] || t.escaped):
+		return &pattern{kind: kindRef, ref: t.text}, nil
+	}
+	return nil, fmt.Errorf("unexpected %s", t)
+}
+
+// datatype reads optional value literal, parameters and except of datatype reference
+func (p *compactParser) datatype(name, library string) (*pattern, error) {
+	if p.peek().kind == tokenLiteral {
+		value, err := p.literal()
+		if err != nil {
+			return nil, err
+		}
+		return &pattern{kind: kindValue, datatype: name, library: library, value: value}, nil
+	}
+	res := &pattern{kind: kindData, datatype: name, library: library}
+	if p.peek().is(tokenOperator, "{") {
+		// Parameters restrict the value space, they are not kept
+		for t := p.next(); !t.is(tokenOperator, "}"); t = p.next() {
+			if t.kind == tokenEOF {
+				return nil, fmt.Errorf("unterminated datatype parameters")
+			}
+		}
+	}
+	if p.peek().is(tokenOperator, "-") {
+		p.next()
+		if _, err := p.primary(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (p *compactParser) braced() (*pattern, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	content, err := p.pattern()
+	if err != nil {
+		return nil, err
+	}
+	return content, p.expect("}")
+}
+
+// nameClass reads name of element or attribute, name classes other than plain name stand
+// for any name
+func (p *compactParser) nameClass(element bool) (xml.Name, error) {
+	name, err := p.simpleNameClass(element)
+	if err != nil {
+		return name, err
+	}
+	for p.peek().is(tokenOperator, "|") || p.peek().is(tokenOperator, "-") {
+		p.next()
+		if _, err := p.simpleNameClass(element); err != nil {
+			return name, err
+		}
+		name = xml.Name{Space: anyNameLocal, Local: anyNameLocal}
+	}
+	return name, nil
+}
+
+func (p *compactParser) simpleNameClass(element bool) (xml.Name, error) {
+	t := p.next()
+	switch {
+	case t.is(tokenOperator, "*"):
+		return xml.Name{Space: anyNameLocal, Local: anyNameLocal}, nil
+	case t.is(tokenOperator, "("):
+		name, err := p.nameClass(element)
+		if err != nil {
+			return name, err
+		}
+		return name, p.expect(")")
+	case t.kind != tokenIdentifier:
+		return xml.Name{}, fmt.Errorf("expected name, found %s", t)
+	}
+	if idx := strings.IndexByte(t.text, ':'); idx != -1 {
+		uri, found := p.xmlns[t.text[:idx]]
+		if !found {
+			return xml.Name{}, fmt.Errorf("undeclared namespace prefix %s", t.text[:idx])
+		}
+		return xml.Name{Space: uri, Local: t.text[idx+1:]}, nil
+	}
+	if element {
+		return xml.Name{Space: p.defaultNs, Local: t.text}, nil
+	}
+	return xml.Name{Local: t.text}, nil
+}
@@ -0,0 +1,210 @@
+// Package rng reads RELAX NG grammars in XML and compact syntax and converts them to
+// xsd.Schema, so that documents defined by RELAX NG get go structs from the same
+// templates as XSD defined ones.
+//
+// Both syntaxes are parsed to the same pattern tree. Named defines (including combine),
+// include with overrides and externalRef of local files are resolved while parsing.
+// Nested grammars, parentRef and name classes other than plain names are not supported,
+// elements named by anyName or nsName become wildcards.
+package rng
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Namespaces of RELAX NG structure and of XSD datatype library
+const (
+	Namespace      = "http://relaxng.org/ns/structure/1.0"
+	XSDDatatypes   = "http://www.w3.org/2001/XMLSchema-datatypes"
+	xmlNamespace   = "http://www.w3.org/XML/1998/namespace"
+	anyNameLocal   = "*"
+	combineChoice  = "choice"
+	combineInterl  = "interleave"
+	compactFileExt = ".rnc"
+)
+
+// Pattern kinds
+const (
+	kindElement    = "element"
+	kindAttribute  = "attribute"
+	kindGroup      = "group"
+	kindInterleave = "interleave"
+	kindChoice     = "choice"
+	kindOptional   = "optional"
+	kindZeroOrMore = "zeroOrMore"
+	kindOneOrMore  = "oneOrMore"
+	kindMixed      = "mixed"
+	kindList       = "list"
+	kindRef        = "ref"
+	kindText       = "text"
+	kindEmpty      = "empty"
+	kindNotAllowed = "notAllowed"
+	kindData       = "data"
+	kindValue      = "value"
+)
+
+// pattern is node of RELAX NG pattern tree
+type pattern struct {
+	kind string
+	// name of element or attribute, Local is "*" for nsName wildcard, Space is "*" too
+	// for anyName
+	name xml.Name
+	// ref is name of the define referred to
+	ref string
+	// datatype of data and value patterns as local name within library
+	datatype string
+	library  string
+	value    string
+	children []*pattern
+}
+
+// Grammar is parsed RELAX NG grammar
+type Grammar struct {
+	path    string
+	start   *pattern
+	defines map[string]*pattern
+	// order of defines as declared
+	order []string
+	// xmlns maps prefixes declared by the grammar to namespaces
+	xmlns map[string]string
+}
+
+// Parse reads RELAX NG grammar from rngPath, files with .rnc extension are read as compact
+// syntax. The read function loads the grammar and files it includes, these are given slash
+// separated paths relative to the directory of the referring file.
+func Parse(rngPath string, read func(path string) ([]byte, error)) (*Grammar, error) {
+	g := &Grammar{path: rngPath, defines: map[string]*pattern{}, xmlns: map[string]string{}}
+	l := loader{read: read, loading: map[string]bool{}}
+	if err := l.load(g, rngPath, nil); err != nil {
+		return nil, err
+	}
+	if g.start == nil {
+		return nil, fmt.Errorf("RELAX NG grammar %s has no start pattern", rngPath)
+	}
+	return g, nil
+}
+
+// SchemaPath names schema converted from the grammar, the generated go package is named
+// after it unless the grammar declares prefix of its namespace
+func (g *Grammar) SchemaPath() string {
+	return strings.TrimSuffix(g.path, path.Ext(g.path)) + ".xsd"
+}
+
+// loader parses grammar files of both syntaxes into Grammar
+type loader struct {
+	read func(path string) ([]byte, error)
+	// loading guards against files including themselves
+	loading map[string]bool
+}
+
+// component is start or define found in grammar content
+type component struct {
+	name    string
+	combine string
+	pattern *pattern
+}
+
+// load parses grammar file and adds its components to g. Components of the overrides
+// replace those of the same name within the file.
+func (l *loader) load(g *Grammar, filePath string, overrides map[string]bool) error {
+	if l.loading[filePath] {
+		return fmt.Errorf("RELAX NG grammar %s includes itself", filePath)
+	}
+	l.loading[filePath] = true
+	defer delete(l.loading, filePath)
+
+	data, err := l.read(filePath)
+	if err != nil {
+		return err
+	}
+	var components []component
+	if path.Ext(filePath) == compactFileExt {
+		components, err = l.parseCompact(g, filePath, string(data))
+	} else {
+		components, err = l.parseXML(g, filePath, data)
+	}
+	if err != nil {
+		return err
+	}
+	for _, c := range components {
+		if overrides[c.name] {
+			continue
+		}
+		if err := g.add(c); err != nil {
+			return fmt.Errorf("%s in %s", err, filePath)
+		}
+	}
+	return nil
+}
+
+// external parses grammar referred to by externalRef and returns its start pattern
+func (l *loader) external(g *Grammar, filePath string) (*pattern, error) {
+	ext := &Grammar{path: filePath, defines: map[string]*pattern{}, xmlns: g.xmlns}
+	if err := l.load(ext, filePath, nil); err != nil {
+		return nil, err
+	}
+	if ext.start == nil {
+		return nil, fmt.Errorf("RELAX NG grammar %s has no start pattern", filePath)
+	}
+	for _, name := range ext.order {
+		if _, found := g.defines[name]; found {
+			return nil, fmt.Errorf("Define %s of %s clashes with define of the referring grammar", name, filePath)
+		}
+		g.defines[name] = ext.defines[name]
+		g.order = append(g.order, name)
+	}
+	return ext.start, nil
+}
+
+// add merges start or define into the grammar honouring its combine method
+func (g *Grammar) add(c component) error {
+	current := g.start
+	if c.name != "" {
+		current = g.defines[c.name]
+	}
+	if current != nil {
+		if c.combine == "" {
+			return fmt.Errorf("Pattern %s is defined more than once without combine", componentName(c.name))
+		}
+		kind := kindChoice
+		if c.combine == combineInterl {
+			kind = kindInterleave
+		}
+		c.pattern = &pattern{kind: kind, children: []*pattern{current, c.pattern}}
+	}
+	if c.name == "" {
+		g.start = c.pattern
+		return nil
+	}
+	if current == nil {
+		g.order = append(g.order, c.name)
+	}
+	g.defines[c.name] = c.pattern
+	return nil
+}
+
+func componentName(name string) string {
+	if name == "" {
+		return "start"
+	}
+	return name
+}
+
+// resolvePath resolves href relative to the referring file
+func resolvePath(referringPath, href string) (string, error) {
+	if strings.Contains(href, "://") {
+		return "", fmt.Errorf("Cannot load %s referred to by %s, only local files are supported", href, referringPath)
+	}
+	return path.Join(path.Dir(referringPath), href), nil
+}
+
+// group wraps several patterns, single pattern is returned as is
+func group(kind string, children []*pattern) *pattern {
+	if len(children) == 1 {
+		return children[0]
+	}
+	return &pattern{kind: kind, children: children}
+}
@@ -0,0 +1,481 @@
+package rng
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/iancoleman/strcase"
+)
+
+// Schema converts the grammar to schema whose target namespace is the namespace of its
+// elements. Elements become global xsd:element named after the element, content models
+// refer to them. When elements of the same name have different content, the later ones
+// are declared locally with named xsd:complexType. Group and interleave become
+// xsd:sequence, so the order of children given by the grammar is expected. Attributes are
+// reduced to their local name, elements named by anyName or nsName become xsd:any.
+func (g *Grammar) Schema() (*xsd.Schema, error) {
+	ns, err := g.namespace()
+	if err != nil {
+		return nil, err
+	}
+	b := &builder{
+		g:         g,
+		ns:        ns,
+		schema:    &xsd.Schema{TargetNamespace: ns},
+		globals:   map[*pattern]string{},
+		elements:  map[string]bool{},
+		types:     map[*pattern]string{},
+		typeNames: map[string]bool{},
+		defineOf:  map[*pattern]string{},
+	}
+	if ns != "" {
+		b.prefix = g.prefixOf(ns)
+		b.schema.ElementFormDefault = "qualified"
+		b.schema.Xmlns = xsd.Xmlns{{Prefix: b.prefix, Uri: ns}}
+	}
+	for _, name := range g.order {
+		if _, found := b.defineOf[g.defines[name]]; !found {
+			b.defineOf[g.defines[name]] = name
+		}
+	}
+	if _, err := b.particle(g.start, &content{}, false, nil); err != nil {
+		return nil, err
+	}
+	if len(b.queue) == 0 {
+		return nil, fmt.Errorf("Start pattern of RELAX NG grammar %s has no named element", g.path)
+	}
+	for i := 0; i < len(b.queue); i++ {
+		p := b.queue[i]
+		if name, global := b.globals[p]; global {
+			el, err := b.convertElement(p, name, false)
+			if err != nil {
+				return nil, err
+			}
+			b.schema.Elements = append(b.schema.Elements, el)
+			continue
+		}
+		el, err := b.convertElement(p, b.types[p], true)
+		if err != nil {
+			return nil, err
+		}
+		el.ComplexType.Name = b.types[p]
+		b.schema.ComplexTypes = append(b.schema.ComplexTypes, *el.ComplexType)
+	}
+	return b.schema, nil
+}
+
+// namespace returns namespace shared by all named elements of the grammar
+func (g *Grammar) namespace() (string, error) {
+	var names []string
+	seen := map[string]bool{}
+	var walk func(p *pattern)
+	walk = func(p *pattern) {
+		if p.kind == kindElement && p.name.Local != anyNameLocal && !seen[p.name.Space] {
+			seen[p.name.Space] = true
+			names = append(names, p.name.Space)
+		}
+		for _, child := range p.children {
+			walk(child)
+		}
+	}
+	walk(g.start)
+	for _, name := range g.order {
+		walk(g.defines[name])
+	}
+	if len(names) > 1 {
+		return "", fmt.Errorf("RELAX NG grammar %s declares elements of several namespaces (%s), only single namespace is supported", g.path, strings.Join(names, ", "))
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	return names[0], nil
+}
+
+// prefixOf returns prefix the grammar declares for ns, or prefix derived from the
+// grammar file name
+func (g *Grammar) prefixOf(ns string) string {
+	for prefix, uri := range g.xmlns {
+		if uri == ns {
+			return prefix
+		}
+	}
+	return strcase.ToSnake(strings.TrimSuffix(path.Base(g.path), path.Ext(g.path)))
+}
+
+// builder converts patterns to schema components
+type builder struct {
+	g      *Grammar
+	ns     string
+	prefix string
+	schema *xsd.Schema
+	// queue holds element patterns to be converted, either to global elements or to
+	// named complex types
+	queue []*pattern
+	// globals maps element patterns to names of global elements
+	globals  map[*pattern]string
+	elements map[string]bool
+	// types maps element patterns declared locally to names of their complex types
+	types     map[*pattern]string
+	typeNames map[string]bool
+	// defineOf maps patterns to name of the define they make up
+	defineOf map[*pattern]string
+}
+
+// content collects what a pattern allows besides particles of the content model
+type content struct {
+	attrs        []xsd.Attribute
+	anyAttribute bool
+	mixed        bool
+	// typ or simpleType give datatype of text content given by data, value or list
+	typ        string
+	simpleType *xsd.SimpleType
+}
+
+func (c *content) addAttribute(attr xsd.Attribute) {
+	for i := range c.attrs {
+		if c.attrs[i].Name == attr.Name {
+			// Attribute present in several branches of choice
+			c.attrs[i].Use = "optional"
+			return
+		}
+	}
+	c.attrs = append(c.attrs, attr)
+}
+
+func (b *builder) reference(name string) string {
+	if b.prefix == "" {
+		return name
+	}
+	return b.prefix + ":" + name
+}
+
+// convertElement converts element pattern to element declaration, with complex set the
+// declaration always gets complex type
+func (b *builder) convertElement(p *pattern, name string, complex bool) (xsd.Element, error) {
+	el := xsd.Element{Name: p.name.Local}
+	c := &content{}
+	model, err := b.particle(p.children[0], c, false, nil)
+	if err != nil {
+		return el, err
+	}
+	ct := &xsd.ComplexType{AttributesDirect: c.attrs}
+	if c.anyAttribute {
+		ct.AnyAttribute = &xsd.AnyAttribute{ProcessContents: "lax"}
+	}
+	switch m := model.(type) {
+	case nil:
+		if c.typ == "" && c.simpleType == nil {
+			if c.mixed {
+				c.typ = "xsd:string"
+			} else {
+				// Element without content
+				el.ComplexType = ct
+				return el, nil
+			}
+		}
+		if len(c.attrs) == 0 && !c.anyAttribute && !complex {
+			el.Type = xsd.Reference(c.typ)
+			el.SimpleType = c.simpleType
+			return el, nil
+		}
+		base := c.typ
+		if c.simpleType != nil {
+			base = b.simpleType(c.simpleType, name)
+		}
+		ct.SimpleContent = &xsd.SimpleContent{Extension: &xsd.Extension{
+			Base: xsd.Reference(base), AttributesDirect: ct.AttributesDirect, AnyAttribute: ct.AnyAttribute,
+		}}
+		ct.AttributesDirect, ct.AnyAttribute = nil, nil
+	case particles:
+		seq := m.sequence()
+		ct.Sequence = &seq
+	case xsd.Sequence:
+		ct.Sequence = &m
+	case xsd.Choice:
+		ct.Choice = &m
+	default:
+		ct.Sequence = &xsd.Sequence{}
+		ct.Sequence.Append(m)
+	}
+	ct.Mixed = model != nil && c.mixed
+	el.ComplexType = ct
+	return el, nil
+}
+
+// simpleType declares global simple type for text content of element with attributes
+func (b *builder) simpleType(st *xsd.SimpleType, name string) string {
+	st.Name = b.uniqueTypeName(name + "-value")
+	b.schema.SimpleTypes = append(b.schema.SimpleTypes, *st)
+	return b.reference(st.Name)
+}
+
+func (b *builder) uniqueTypeName(name string) string {
+	res := name
+	for i := 2; b.typeNames[res] || b.elements[res]; i++ {
+		res = name + strconv.Itoa(i)
+	}
+	b.typeNames[res] = true
+	return res
+}
+
+// particles are members of group not yet wrapped in xsd.Sequence, so that groups nested
+// by references to defines end up in single sequence
+type particles []interface{}
+
+func (parts particles) sequence() xsd.Sequence {
+	seq := xsd.Sequence{}
+	for _, part := range parts {
+		seq.Append(part)
+	}
+	return seq
+}
+
+// particle converts pattern to xsd.Element, xsd.Any, xsd.Choice or particles, or
+// returns nil for patterns without element content. Attributes and text content are
+// collected to c. The refs guard against defines referring to themselves.
+func (b *builder) particle(p *pattern, c *content, optional bool, refs map[string]bool) (interface{}, error) {
+	switch p.kind {
+	case kindElement:
+		return b.elementParticle(p)
+	case kindAttribute:
+		return nil, b.attribute(p, c, optional)
+	case kindGroup, kindInterleave:
+		var parts particles
+		for _, child := range p.children {
+			part, err := b.particle(child, c, optional, refs)
+			if err != nil {
+				return nil, err
+			}
+			if nested, ok := part.(particles); ok {
+				parts = append(parts, nested...)
+			} else if part != nil {
+				parts = append(parts, part)
+			}
+		}
+		switch len(parts) {
+		case 0:
+			return nil, nil
+		case 1:
+			return parts[0], nil
+		}
+		return parts, nil
+	case kindChoice:
+		return b.choice(p, c, refs)
+	case kindOptional:
+		part, err := b.particle(p.children[0], c, true, refs)
+		return withOccurs(part, "0", ""), err
+	case kindZeroOrMore:
+		part, err := b.particle(p.children[0], c, true, refs)
+		return withOccurs(part, "0", "unbounded"), err
+	case kindOneOrMore:
+		part, err := b.particle(p.children[0], c, optional, refs)
+		return withOccurs(part, "", "unbounded"), err
+	case kindMixed:
+		c.mixed = true
+		return b.particle(p.children[0], c, optional, refs)
+	case kindRef:
+		if refs[p.ref] {
+			return nil, fmt.Errorf("Define %s of RELAX NG grammar %s refers to itself outside of element", p.ref, b.g.path)
+		}
+		def, found := b.g.defines[p.ref]
+		if !found {
+			return nil, fmt.Errorf("Reference to undefined %s in RELAX NG grammar %s", p.ref, b.g.path)
+		}
+		nested := map[string]bool{p.ref: true}
+		for name := range refs {
+			nested[name] = true
+		}
+		return b.particle(def, c, optional, nested)
+	case kindText:
+		c.mixed = true
+	case kindData, kindValue, kindList:
+		c.typ, c.simpleType = b.datatype(p)
+	}
+	return nil, nil
+}
+
+// choice converts choice pattern, choice of values becomes enumeration
+func (b *builder) choice(p *pattern, c *content, refs map[string]bool) (interface{}, error) {
+	if st := b.enumeration(p); st != nil {
+		c.typ, c.simpleType = "", st
+		return nil, nil
+	}
+	choice := xsd.Choice{}
+	var parts []interface{}
+	for _, child := range p.children {
+		if child.kind == kindEmpty {
+			choice.MinOccurs = "0"
+			continue
+		}
+		part, err := b.particle(child, c, true, refs)
+		if err != nil {
+			return nil, err
+		}
+		if nested, ok := part.(particles); ok {
+			part = nested.sequence()
+		}
+		if part != nil {
+			parts = append(parts, part)
+			choice.Append(part)
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return nil, nil
+	case 1:
+		return withOccurs(parts[0], choice.MinOccurs, ""), nil
+	}
+	return choice, nil
+}
+
+// enumeration returns simple type enumerating the values when all choices are values
+func (b *builder) enumeration(p *pattern) *xsd.SimpleType {
+	restriction := &xsd.Restriction{}
+	for _, child := range p.children {
+		if child.kind != kindValue {
+			return nil
+		}
+		restriction.Base = datatypeName(child)
+		restriction.Enumerations = append(restriction.Enumerations, xsd.Enumeration{Value: value(child)})
+	}
+	return &xsd.SimpleType{Restriction: restriction}
+}
+
+// datatype returns XSD type of data, value or list pattern, either as reference to builtin
+// type or as anonymous simple type
+func (b *builder) datatype(p *pattern) (string, *xsd.SimpleType) {
+	switch p.kind {
+	case kindValue:
+		return "", &xsd.SimpleType{Restriction: &xsd.Restriction{
+			Base:         datatypeName(p),
+			Enumerations: []xsd.Enumeration{{Value: value(p)}},
+		}}
+	case kindList:
+		itemType := "xsd:token"
+		if item := p.children[0]; item.kind == kindData {
+			itemType = datatypeName(item)
+		}
+		return "", &xsd.SimpleType{List: &xsd.List{ItemType: itemType}}
+	}
+	return datatypeName(p), nil
+}
+
+// datatypeName maps datatype of data or value pattern to XSD builtin type. The RELAX NG
+// builtin library has string and token, types of other libraries are taken for strings.
+func datatypeName(p *pattern) string {
+	switch {
+	case p.library == XSDDatatypes:
+		return "xsd:" + p.datatype
+	case p.library == "" && p.datatype == "token":
+		return "xsd:token"
+	}
+	return "xsd:string"
+}
+
+// value returns value of value pattern, token values are compared with whitespace collapsed
+func value(p *pattern) string {
+	if p.datatype == "token" {
+		return strings.Join(strings.Fields(p.value), " ")
+	}
+	return p.value
+}
+
+func (b *builder) attribute(p *pattern, c *content, optional bool) error {
+	if p.name.Local == anyNameLocal {
+		c.anyAttribute = true
+		return nil
+	}
+	attr := xsd.Attribute{Name: p.name.Local, Use: "required"}
+	if optional {
+		attr.Use = "optional"
+	}
+	attrContent := &content{}
+	if _, err := b.particle(p.children[0], attrContent, false, nil); err != nil {
+		return err
+	}
+	switch {
+	case attrContent.simpleType != nil && attrContent.simpleType.Restriction != nil && len(attrContent.simpleType.Restriction.Enumerations) == 1:
+		attr.Type = attrContent.simpleType.Restriction.Base
+		attr.Fixed = attrContent.simpleType.Restriction.Enumerations[0].Value
+	case attrContent.simpleType != nil:
+		attr.SimpleType = attrContent.simpleType
+	case attrContent.typ != "":
+		attr.Type = attrContent.typ
+	default:
+		attr.Type = "xsd:string"
+	}
+	c.addAttribute(attr)
+	return nil
+}
+
+// elementParticle refers to global element of the pattern, the first element pattern of
+// each name becomes global, others are declared locally
+func (b *builder) elementParticle(p *pattern) (interface{}, error) {
+	if p.name.Local == anyNameLocal {
+		wildcard := xsd.Any{ProcessContents: "lax"}
+		switch p.name.Space {
+		case anyNameLocal:
+			wildcard.Namespace = "##any"
+		case "":
+			wildcard.Namespace = "##local"
+		case b.ns:
+			wildcard.Namespace = "##targetNamespace"
+		default:
+			wildcard.Namespace = p.name.Space
+		}
+		return wildcard, nil
+	}
+	if name, found := b.globals[p]; found {
+		return xsd.Element{Ref: xsd.Reference(b.reference(name))}, nil
+	}
+	if !b.elements[p.name.Local] {
+		b.elements[p.name.Local] = true
+		b.globals[p] = p.name.Local
+		b.queue = append(b.queue, p)
+		return xsd.Element{Ref: xsd.Reference(b.reference(p.name.Local))}, nil
+	}
+	typeName, found := b.types[p]
+	if !found {
+		typeName = p.name.Local
+		if define, found := b.defineOf[p]; found {
+			typeName = define
+		}
+		typeName = b.uniqueTypeName(typeName)
+		b.types[p] = typeName
+		b.queue = append(b.queue, p)
+	}
+	return xsd.Element{Name: p.name.Local, Type: xsd.Reference(b.reference(typeName))}, nil
+}
+
+// withOccurs relaxes occurrence of particle, nil particle is returned as is
+func withOccurs(part interface{}, minOccurs, maxOccurs string) interface{} {
+	relax := func(min, max *string) {
+		if minOccurs == "0" {
+			*min = "0"
+		}
+		if maxOccurs == "unbounded" {
+			*max = "unbounded"
+		}
+	}
+	if parts, ok := part.(particles); ok {
+		part = parts.sequence()
+	}
+	switch p := part.(type) {
+	case xsd.Element:
+		relax(&p.MinOccurs, &p.MaxOccurs)
+		return p
+	case xsd.Any:
+		relax(&p.MinOccurs, &p.MaxOccurs)
+		return p
+	case xsd.Sequence:
+		relax(&p.MinOccurs, &p.MaxOccurs)
+		return p
+	case xsd.Choice:
+		relax(&p.MinOccurs, &p.MaxOccurs)
+		return p
+	}
+	return part
+}
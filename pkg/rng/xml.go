@@ -0,0 +1,266 @@
+package rng
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xmlNode is element of grammar in XML syntax with namespace declarations in scope
+type xmlNode struct {
+	name     xml.Name
+	attrs    map[string]string
+	children []*xmlNode
+	text     string
+	xmlns    map[string]string
+}
+
+func (n *xmlNode) rngChildren() []*xmlNode {
+	var res []*xmlNode
+	for _, child := range n.children {
+		if child.name.Space == Namespace {
+			res = append(res, child)
+		}
+	}
+	return res
+}
+
+func readXMLTree(data []byte) (*xmlNode, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*xmlNode
+	var root *xmlNode
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return root, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &xmlNode{name: t.Name, attrs: map[string]string{}, xmlns: map[string]string{"xml": xmlNamespace}}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				for prefix, uri := range parent.xmlns {
+					n.xmlns[prefix] = uri
+				}
+				parent.children = append(parent.children, n)
+			} else {
+				root = n
+			}
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "xmlns":
+					n.xmlns[attr.Name.Local] = attr.Value
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					n.xmlns[""] = attr.Value
+				case attr.Name.Space == "":
+					n.attrs[attr.Name.Local] = attr.Value
+				}
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+}
+
+// xmlContext holds values inherited from ancestors: ns and datatypeLibrary attributes
+type xmlContext struct {
+	ns      string
+	library string
+}
+
+func (c xmlContext) inherit(n *xmlNode) xmlContext {
+	if ns, found := n.attrs["ns"]; found {
+		c.ns = ns
+	}
+	if lib, found := n.attrs["datatypeLibrary"]; found {
+		c.library = lib
+	}
+	return c
+}
+
+type xmlReader struct {
+	l    *loader
+	g    *Grammar
+	path string
+}
+
+func (l *loader) parseXML(g *Grammar, filePath string, data []byte) ([]component, error) {
+	root, err := readXMLTree(data)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding RELAX NG grammar %s: %s", filePath, err)
+	}
+	if root == nil || root.name.Space != Namespace {
+		return nil, fmt.Errorf("%s is not RELAX NG grammar", filePath)
+	}
+	for prefix, uri := range root.xmlns {
+		if _, found := g.xmlns[prefix]; !found && prefix != "" && prefix != "xml" {
+			g.xmlns[prefix] = uri
+		}
+	}
+	r := &xmlReader{l: l, g: g, path: filePath}
+	ctx := xmlContext{}.inherit(root)
+	if root.name.Local == "grammar" {
+		return r.grammarContent(root, ctx)
+	}
+	p, err := r.pattern(root, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []component{{pattern: p}}, nil
+}
+
+// grammarContent reads start, define, div and include children of grammar or include
+func (r *xmlReader) grammarContent(n *xmlNode, ctx xmlContext) ([]component, error) {
+	var res []component
+	for _, child := range n.rngChildren() {
+		childCtx := ctx.inherit(child)
+		switch child.name.Local {
+		case "start", "define":
+			p, err := r.group(kindGroup, child.rngChildren(), childCtx)
+			if err != nil {
+				return nil, err
+			}
+			name := ""
+			if child.name.Local == "define" {
+				name = child.attrs["name"]
+			}
+			res = append(res, component{name: name, combine: child.attrs["combine"], pattern: p})
+		case "div":
+			components, err := r.grammarContent(child, childCtx)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, components...)
+		case "include":
+			components, err := r.grammarContent(child, childCtx)
+			if err != nil {
+				return nil, err
+			}
+			if err := r.include(child.attrs["href"], components); err != nil {
+				return nil, err
+			}
+			res = append(res, components...)
+		}
+	}
+	return res, nil
+}
+
+// include loads included grammar leaving out components overridden by the include
+func (r *xmlReader) include(href string, overriding []component) error {
+	includePath, err := resolvePath(r.path, href)
+	if err != nil {
+		return err
+	}
+	overrides := map[string]bool{}
+	for _, c := range overriding {
+		overrides[c.name] = true
+	}
+	return r.l.load(r.g, includePath, overrides)
+}
+
+func (r *xmlReader) group(kind string, nodes []*xmlNode, ctx xmlContext) (*pattern, error) {
+	var children []*pattern
+	for _, n := range nodes {
+		p, err := r.pattern(n, ctx.inherit(n))
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, p)
+	}
+	if len(children) == 0 {
+		return nil, fmt.Errorf("Empty %s in %s", kind, r.path)
+	}
+	return group(kind, children), nil
+}
+
+func (r *xmlReader) pattern(n *xmlNode, ctx xmlContext) (*pattern, error) {
+	children := n.rngChildren()
+	switch kind := n.name.Local; kind {
+	case kindElement, kindAttribute:
+		p := &pattern{kind: kind}
+		if name, found := n.attrs["name"]; found {
+			p.name = r.qname(n, name, ctx, kind == kindElement)
+		} else if len(children) > 0 {
+			p.name = r.nameClass(children[0], ctx.inherit(children[0]), kind == kindElement)
+			children = children[1:]
+		} else {
+			return nil, fmt.Errorf("%s without name in %s", kind, r.path)
+		}
+		if len(children) == 0 {
+			if kind == kindElement {
+				return nil, fmt.Errorf("element %s without content in %s", p.name.Local, r.path)
+			}
+			p.children = []*pattern{{kind: kindText}}
+			return p, nil
+		}
+		content, err := r.group(kindGroup, children, ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.children = []*pattern{content}
+		return p, nil
+	case kindGroup, kindInterleave, kindChoice:
+		return r.group(kind, children, ctx)
+	case kindOptional, kindZeroOrMore, kindOneOrMore, kindMixed, kindList:
+		content, err := r.group(kindGroup, children, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &pattern{kind: kind, children: []*pattern{content}}, nil
+	case kindRef:
+		return &pattern{kind: kindRef, ref: n.attrs["name"]}, nil
+	case kindText, kindEmpty, kindNotAllowed:
+		return &pattern{kind: kind}, nil
+	case kindData:
+		return &pattern{kind: kindData, datatype: n.attrs["type"], library: ctx.library}, nil
+	case kindValue:
+		p := &pattern{kind: kindValue, datatype: n.attrs["type"], library: ctx.library, value: n.text}
+		if p.datatype == "" {
+			p.datatype, p.library = "token", ""
+		}
+		return p, nil
+	case "externalRef":
+		externalPath, err := resolvePath(r.path, n.attrs["href"])
+		if err != nil {
+			return nil, err
+		}
+		return r.l.external(r.g, externalPath)
+	default:
+		return nil, fmt.Errorf("RELAX NG %s in %s is not supported", kind, r.path)
+	}
+}
+
+// qname resolves name of element or attribute, unprefixed element names are in ns
+func (r *xmlReader) qname(n *xmlNode, name string, ctx xmlContext, element bool) xml.Name {
+	name = strings.TrimSpace(name)
+	if idx := strings.IndexByte(name, ':'); idx != -1 {
+		return xml.Name{Space: n.xmlns[name[:idx]], Local: name[idx+1:]}
+	}
+	if element {
+		return xml.Name{Space: ctx.ns, Local: name}
+	}
+	return xml.Name{Local: name}
+}
+
+// nameClass reads name class child of element or attribute, name classes other than plain
+// name stand for any name
+func (r *xmlReader) nameClass(n *xmlNode, ctx xmlContext, element bool) xml.Name {
+	switch n.name.Local {
+	case "name":
+		return r.qname(n, n.text, ctx, element)
+	case "nsName":
+		return xml.Name{Space: ctx.ns, Local: anyNameLocal}
+	default:
+		return xml.Name{Space: anyNameLocal, Local: anyNameLocal}
+	}
+}
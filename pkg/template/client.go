@@ -0,0 +1,41 @@
+package template
+
+import (
+	"bytes"
+	"errors"
+	"go/format"
+	"strconv"
+	"text/template"
+
+	"github.com/gocomply/xsd2go/pkg/wsdl"
+	"github.com/markbates/pkger"
+)
+
+// clientTemplate renders SOAP client stubs of wsdl:service, it is not executed for schemas
+var clientTemplate = pkger.Include("/pkg/template/client.tmpl")
+
+// RenderClient renders SOAP client stubs of the service as client.go of its own go package
+func RenderClient(service *wsdl.Service) (*File, error) {
+	text, err := readBuiltin(clientTemplate)
+	if err != nil {
+		return nil, err
+	}
+	t, err := template.New("client.tmpl").Funcs(template.FuncMap{
+		"quote":   strconv.Quote,
+		"comment": comment,
+		"dict":    dict,
+	}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, service); err != nil {
+		return nil, err
+	}
+	p, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.New(err.Error() + " in following file:\n" + buf.String())
+	}
+	return &File{Path: service.GoPackageName + "/client.go", Content: p}, nil
+}
@@ -0,0 +1,81 @@
+package template
+
+import (
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// codecStruct describes go struct for which codec.tmpl renders UnmarshalXML and MarshalXML
+type codecStruct struct {
+	GoName string
+	// ElementName is set for structs generated from xsd:element, these carry XMLName
+	ElementName  string
+	Attributes   []xsd.Attribute
+	Elements     []xsd.Element
+	ContainsText bool
+}
+
+// newCodecStruct drops fields sharing xml name with preceding field, encoding/xml would
+// never fill them either
+func newCodecStruct(goName, elementName string, attributes []xsd.Attribute, elements []xsd.Element, text bool) codecStruct {
+	res := codecStruct{GoName: goName, ElementName: elementName, ContainsText: text}
+	seen := map[string]bool{}
+	for _, attr := range attributes {
+		if !seen[attr.XmlName()] {
+			seen[attr.XmlName()] = true
+			res.Attributes = append(res.Attributes, attr)
+		}
+	}
+	seen = map[string]bool{}
+	for _, el := range elements {
+		if !seen[el.XmlName()] {
+			seen[el.XmlName()] = true
+			res.Elements = append(res.Elements, el)
+		}
+	}
+	return res
+}
+
+// UsesToken reports whether decoded token is needed beyond skipping unknown elements
+func (cs codecStruct) UsesToken() bool {
+	return cs.ContainsText || len(cs.Elements) > 0
+}
+
+func codecElement(el xsd.Element) codecStruct {
+	return newCodecStruct(el.GoName(), el.Name, el.Attributes(), el.Elements(), el.ContainsText())
+}
+
+func codecComplexType(ct xsd.ComplexType) codecStruct {
+	return newCodecStruct(ct.GoName(), "", ct.Attributes(), ct.Elements(), ct.ContainsText())
+}
+
+// codecKind classifies go type of a field: string, int, uint, float, bool or struct
+func codecKind(goType string) string {
+	switch {
+	case goType == "string" || goType == "bool":
+		return goType
+	case strings.HasPrefix(goType, "int"):
+		return "int"
+	case strings.HasPrefix(goType, "uint"):
+		return "uint"
+	case strings.HasPrefix(goType, "float"):
+		return "float"
+	}
+	return "struct"
+}
+
+// codecBits returns bit size of numeric go type as expected by strconv, 0 for int and uint
+func codecBits(goType string) string {
+	bits := strings.TrimLeft(goType, "intufloa")
+	if bits == "" {
+		return "0"
+	}
+	return bits
+}
+
+// codecDirect reports whether the struct of element field has generated codec methods
+// that can be called without going through encoding/xml reflection
+func codecDirect(el xsd.Element) bool {
+	return el.GoForeignModule() == "" && codecKind(el.GoTypeName()) == "struct" && !el.ContainsInnerXml() && !el.HasOpenContent()
+}
@@ -0,0 +1,61 @@
+// Package template renders go source code from compiled XSD schemas.
+//
+// Every template is executed once per schema of the generated go package with
+// *SchemaFile: the *xsd.Schema plus .DeclaresHelpers, which tells whether declarations
+// shared by the whole package, such as helper functions, belong to this file. Only the
+// first output of the template in the package declares them. With source layout, or when
+// several schemas share the package, outputs are named after the source XSD
+// (orders_stream.go). The exception is types.tmpl, executed once per file of
+// Options.Layout with *PackageFile: the schema narrowed down to the structs of the file,
+// its .DeclaresMixedElement tells where generic MixedElement goes. Templates may use
+// following parts of the data model:
+//
+//	.GoPackageName            name of the generated go package
+//	.TargetNamespace          xmlns of the schema
+//	.GoImportsNeeded          go import paths required by the generated code, encoding/xml
+//	                          is left out of types.tmpl files declaring no elements
+//	.ExportableElements       top-level and inlined xsd:elements ([]xsd.Element)
+//	.ExportableComplexTypes   xsd:complexTypes not shadowed by an element ([]xsd.ComplexType)
+//	.SimpleTypes              xsd:simpleTypes ([]xsd.SimpleType)
+//	.Redefines, .Overrides    xsd:redefine and xsd:override, their components are merged in
+//	.Xmlns                    xmlns prefix declarations of the schema
+//
+// Elements expose .GoName, .GoFieldName, .GoTypeName, .GoMemLayout, .GoForeignModule,
+// .XmlName, .Attributes, .Elements, .ContainsText and .Documentation. Attributes expose
+// .GoName, .XmlName, .Modifiers and .Documentation. Complex types additionally expose
+// .ContainsInnerXml. Elements list xsd:unique, xsd:key and xsd:keyref in
+// .IdentityConstraints; the built-in identity.tmpl provides runtime helpers for them and
+// is rendered only when .HasIdentityConstraints. Similarly ids.tmpl is rendered when
+// .HasIDs, using .IDKind of attributes and elements and .ContainsIDs and .DeclaresIDs
+// of generated structs. assertions.tmpl is rendered when .HasAssertions, using .Assertions
+// of elements and complex types and .Alternatives of elements. Structs with .HasOpenContent
+// get OpenContent field, .DeclaresOpenContentElement tells where its element type goes.
+// stream.tmpl renders decoders for .StreamableElements and
+// codec.tmpl renders UnmarshalXML and MarshalXML when Options.XMLCodecs is set.
+// Partials of _mixed.tmpl render mixed content nodes when Options.MixedContent is nodes,
+// mixedNodes TYPE tells whether that is the case for given complex type. Element and
+// type lists are sorted by go name when Options.Order is name.
+//
+// User supplied templates are loaded from Options.TemplateDir. File foo.tmpl produces
+// foo.go in the generated package (foo.txt.tmpl produces foo.txt), types.tmpl replaces
+// the built-in template producing models.go and files starting with underscore are
+// partials meant to be used by {{template}}. Output that renders to whitespace only is
+// not written at all.
+//
+// RenderClient renders SOAP client of *wsdl.Service using client.tmpl, which cannot be
+// overridden from Options.TemplateDir.
+//
+// Built-in functions:
+//
+//	camel, lowerCamel, snake, screamingSnake, kebab, upper, lower   case conversion
+//	trim, hasPrefix, hasSuffix, contains, replace, join, split, quote
+//	dict KEY VALUE...         map for passing several values to a partial
+//	options                   Options the generator runs with
+//	wrap WIDTH TEXT           re-flow text to given line width
+//	comment TEXT              format text as wrapped go line comments
+//	nsPrefix REF, localName REF   split qualified name such as "xccdf:Rule"
+//	nsURI PREFIX, prefixOf URI    xmlns lookups as seen from current schema
+//	lookupType REF, lookupElement REF, lookupAttribute REF   resolve schema components
+//	elementTag EL, attributeTag ATTR, xmlNameTag NAME, textTag, innerXmlTag, openContentTag
+//	                          struct tags honouring Options.JSONTags, YAMLTags and TagNaming
+package template
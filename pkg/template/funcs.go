@@ -0,0 +1,133 @@
+package template
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/iancoleman/strcase"
+)
+
+const commentWidth = 80
+
+// funcMap returns functions available to all templates. Namespace and lookup helpers
+// resolve prefixes as seen from the schema currently being generated.
+func funcMap(schema *xsd.Schema, opts Options) template.FuncMap {
+	return template.FuncMap{
+		// Case conversion
+		"camel":          strcase.ToCamel,
+		"lowerCamel":     strcase.ToLowerCamel,
+		"snake":          strcase.ToSnake,
+		"screamingSnake": strcase.ToScreamingSnake,
+		"kebab":          strcase.ToKebab,
+		"upper":          strings.ToUpper,
+		"lower":          strings.ToLower,
+
+		// Strings
+		"trim":      strings.TrimSpace,
+		"hasPrefix": strings.HasPrefix,
+		"hasSuffix": strings.HasSuffix,
+		"contains":  strings.Contains,
+		"replace":   func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"join":      func(sep string, elems []string) string { return strings.Join(elems, sep) },
+		"split":     func(sep, s string) []string { return strings.Split(s, sep) },
+		"quote":     strconv.Quote,
+		"dict":      dict,
+
+		// Documentation
+		"wrap":    wrap,
+		"comment": comment,
+
+		// Namespaces
+		"nsPrefix":  func(ref string) string { return refPrefix(ref) },
+		"localName": func(ref string) string { return refLocalName(ref) },
+		"nsURI":     schema.NamespaceByPrefix,
+		"prefixOf":  schema.Xmlns.PrefixByUri,
+
+		// Lookups of schema components visible from current schema
+		"lookupType":      schema.LookupType,
+		"lookupElement":   schema.LookupElement,
+		"lookupAttribute": schema.LookupAttribute,
+
+		// Struct tags honouring json & yaml tag options
+		"elementTag":     opts.elementTag,
+		"attributeTag":   opts.attributeTag,
+		"xmlNameTag":     opts.xmlNameTag,
+		"textTag":        opts.textTag,
+		"innerXmlTag":    opts.innerXmlTag,
+		"openContentTag": opts.openContentTag,
+		"contentTag":     opts.contentTag,
+
+		// Generator options and helpers of the built-in codec.tmpl
+		"options":          func() Options { return opts },
+		"codecElement":     codecElement,
+		"codecComplexType": codecComplexType,
+		"codecKind":        codecKind,
+		"codecBits":        codecBits,
+		"codecDirect":      codecDirect,
+		"mixedNodes":       opts.mixedNodes,
+	}
+}
+
+// dict builds map from key value pairs so that partials can receive several arguments
+func dict(pairs ...interface{}) (map[string]interface{}, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("dict expects even number of arguments")
+	}
+	res := map[string]interface{}{}
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict keys must be strings, got %v", pairs[i])
+		}
+		res[key] = pairs[i+1]
+	}
+	return res, nil
+}
+
+// wrap re-flows text so that no line exceeds width characters, unless single word is longer
+func wrap(width int, text string) string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			if line != "" && len(line)+1+len(word) > width {
+				lines = append(lines, line)
+				line = ""
+			}
+			if line != "" {
+				line += " "
+			}
+			line += word
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// comment turns text into go line comments; empty text produces empty string
+func comment(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(wrap(commentWidth-3, text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("// "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func refPrefix(ref string) string {
+	colonPos := strings.Index(ref, ":")
+	if colonPos == -1 {
+		return ""
+	}
+	return ref[0:colonPos]
+}
+
+func refLocalName(ref string) string {
+	return ref[strings.Index(ref, ":")+1:]
+}
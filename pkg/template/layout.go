@@ -0,0 +1,234 @@
+package template
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/iancoleman/strcase"
+)
+
+// Layouts of the go structs generated by types.tmpl
+const (
+	// LayoutSingle puts all structs of the package to models.go
+	LayoutSingle = "single"
+	// LayoutComponent generates file per top-level xsd:element or xsd:complexType
+	LayoutComponent = "component"
+	// LayoutSource names the file after the source XSD, so that several schemas may share a package
+	LayoutSource = "source"
+	// LayoutKind generates elements.go, complex_types.go and helpers.go
+	LayoutKind = "kind"
+)
+
+// PackageFile is the data types.tmpl is executed with: the schema narrowed down to the
+// components that belong to one output file under selected Options.Layout
+type PackageFile struct {
+	*xsd.Schema
+	// DeclaresMixedElement tells whether the generic MixedElement type belongs to this file
+	DeclaresMixedElement bool
+	// DeclaresOpenContentElement tells whether the OpenContentElement type belongs to this file
+	DeclaresOpenContentElement bool
+	fileName                   string
+	elements                   []xsd.Element
+	complexTypes               []xsd.ComplexType
+	// usesXML tells whether declarations of the file refer to encoding/xml
+	usesXML bool
+}
+
+// GoImportsNeeded leaves encoding/xml out unless the file declares elements, which carry
+// XMLName, mixed content nodes or OpenContentElement
+func (f *PackageFile) GoImportsNeeded() []string {
+	var imports []string
+	for _, imp := range f.Schema.GoImportsNeeded() {
+		if imp != "encoding/xml" || f.usesXML {
+			imports = append(imports, imp)
+		}
+	}
+	return imports
+}
+
+func (f *PackageFile) ExportableElements() []xsd.Element {
+	return f.elements
+}
+
+func (f *PackageFile) ExportableComplexTypes() []xsd.ComplexType {
+	return f.complexTypes
+}
+
+// SchemaFile is the data templates other than types.tmpl are executed with. Every schema of
+// a go package renders each template, declarations shared by the package go to the first
+// file the template produces.
+type SchemaFile struct {
+	*xsd.Schema
+	// DeclaresHelpers tells whether declarations shared by the whole package belong to this file
+	DeclaresHelpers bool
+	fileName        string
+}
+
+// schemaFileName names the output of templates other than types.tmpl. Schemas that share the
+// go package, or are generated with source layout, get a file each named after the source XSD.
+func (opts Options) schemaFileName(schema *xsd.Schema, templateName string, shared bool, reserved map[string]bool) string {
+	name := outputFileName(templateName)
+	if !shared && !schema.SharesGoPackage() && opts.Layout != LayoutSource {
+		return name
+	}
+	if path.Ext(name) == ".go" {
+		return goFileName(schema.SourceName()+"_"+strings.TrimSuffix(name, ".go"), reserved)
+	}
+	return nonIdentifierChars.ReplaceAllString(strcase.ToSnake(schema.SourceName()), "_") + "_" + name
+}
+
+// packageFiles splits structs generated for the schema to files according to the layout
+func (opts Options) packageFiles(schema *xsd.Schema, reserved map[string]bool) []*PackageFile {
+	mixed, open := false, false
+	for _, ct := range schema.ExportableComplexTypes() {
+		mixed = mixed || opts.mixedNodes(ct)
+		open = open || ct.HasOpenContent()
+	}
+	for _, el := range schema.ExportableElements() {
+		open = open || el.HasOpenContent()
+	}
+	whole := &PackageFile{
+		Schema:                     schema,
+		DeclaresMixedElement:       mixed,
+		DeclaresOpenContentElement: open,
+		fileName:                   "models.go",
+		elements:                   schema.ExportableElements(),
+		complexTypes:               schema.ExportableComplexTypes(),
+	}
+
+	layout := opts.Layout
+	if schema.SharesGoPackage() && (layout == "" || layout == LayoutSingle) {
+		// Schemas sharing the go package to break import cycle get a file each
+		layout = LayoutSource
+	}
+	var files []*PackageFile
+	switch layout {
+	case LayoutSource:
+		whole.fileName = goFileName(schema.SourceName(), reserved)
+		return []*PackageFile{opts.withXMLUsage(whole)}
+	case LayoutKind:
+		files = []*PackageFile{
+			{Schema: schema, fileName: "elements.go", elements: whole.elements},
+			{Schema: schema, fileName: "complex_types.go", complexTypes: whole.complexTypes},
+			{Schema: schema, fileName: "helpers.go", DeclaresMixedElement: mixed, DeclaresOpenContentElement: open},
+		}
+	case LayoutComponent:
+		for _, c := range schema.Components() {
+			files = append(files, &PackageFile{
+				Schema:       schema,
+				fileName:     goFileName(c.Name, reserved),
+				elements:     c.Elements,
+				complexTypes: c.ComplexTypes,
+			})
+		}
+		files = append(files,
+			&PackageFile{Schema: schema, fileName: goFileName("MixedElement", reserved), DeclaresMixedElement: mixed},
+			&PackageFile{Schema: schema, fileName: goFileName("OpenContentElement", reserved), DeclaresOpenContentElement: open})
+	default:
+		return []*PackageFile{opts.withXMLUsage(whole)}
+	}
+
+	var res []*PackageFile
+	for _, f := range files {
+		if len(f.elements) != 0 || len(f.complexTypes) != 0 || f.DeclaresMixedElement || f.DeclaresOpenContentElement {
+			res = append(res, opts.withXMLUsage(f))
+		}
+	}
+	return res
+}
+
+// withXMLUsage records whether the file refers to encoding/xml, see GoImportsNeeded
+func (opts Options) withXMLUsage(f *PackageFile) *PackageFile {
+	f.usesXML = len(f.elements) != 0 || f.DeclaresMixedElement || f.DeclaresOpenContentElement
+	for _, ct := range f.complexTypes {
+		f.usesXML = f.usesXML || opts.mixedNodes(ct)
+	}
+	return f
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// goFileName turns name of a component or schema to go file name that does not clash with
+// other generated files, is not a _test.go file and does not imply build constraints
+func goFileName(name string, reserved map[string]bool) string {
+	base := nonIdentifierChars.ReplaceAllString(strcase.ToSnake(name), "_")
+	parts := strings.Split(base, "_")
+	last := parts[len(parts)-1]
+	if base == "" || reserved[base+".go"] || last == "test" || knownOSArch[last] {
+		base += "_model"
+	}
+	return base + ".go"
+}
+
+// knownOSArch are file name suffixes go build treats as GOOS or GOARCH constraints
+var knownOSArch = map[string]bool{}
+
+func init() {
+	for _, name := range strings.Fields(`aix android darwin dragonfly freebsd hurd illumos ios js
+		linux nacl netbsd openbsd plan9 solaris wasip1 windows zos
+		386 amd64 amd64p32 arm armbe arm64 arm64be loong64 mips mipsle mips64 mips64le mips64p32
+		mips64p32le ppc ppc64 ppc64le riscv riscv64 s390 s390x sparc sparc64 wasm`) {
+		knownOSArch[name] = true
+	}
+}
+
+// pruneImports removes imports not referenced by the go source. Every file of layouts other
+// than single starts with the imports of the whole package, while only some are needed.
+func pruneImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	var decls []ast.Decl
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			decls = append(decls, decl)
+			continue
+		}
+		var specs []ast.Spec
+		for _, spec := range gen.Specs {
+			imp := spec.(*ast.ImportSpec)
+			importPath, _ := strconv.Unquote(imp.Path.Value)
+			name := path.Base(importPath)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			if used[name] || name == "_" || name == "." {
+				specs = append(specs, spec)
+			}
+		}
+		if len(specs) != 0 {
+			gen.Specs = specs
+			decls = append(decls, gen)
+		}
+	}
+	f.Decls = decls
+	f.Imports = nil
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,23 @@
+package template
+
+import (
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Representations of mixed content (complex types with mixed="true")
+const (
+	// MixedContentInnerXml keeps raw inner xml of mixed content in InnerXml string
+	MixedContentInnerXml = "innerxml"
+	// MixedContentNodes keeps mixed content as ordered list of text and typed element nodes
+	MixedContentNodes = "nodes"
+)
+
+// mixedNodes reports whether the struct generated for given complex type holds mixed content as list of nodes
+func (opts Options) mixedNodes(typ interface{}) bool {
+	ct, ok := typ.(xsd.ComplexType)
+	return ok && opts.MixedContent == MixedContentNodes && ct.ContainsInnerXml()
+}
+
+func (opts Options) contentTag() string {
+	return opts.structTag("-", "content", true)
+}
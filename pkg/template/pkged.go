@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec585b73e23816fe2b537a5d4f7c012798b7403a403ac94c20c1e0aeae2ed912b6c6b2e4b5e404a72bff7d4bb23186cead66de76f781f89ca3a373f9cec5909f80b00d1760f813a8cf0529c0109809cfb02968994241d2df373dc7445042b3c02881d28c8934933234631ef12ca795b915c8893930c02ccb7921ff84320143000c700b330c86a03dbfe0517d700f8b18cb9a9e73de503750460918b29252032c24a4180c37900adc70730c0567b5ee845f128ac54ebbf6dcb217386fe97b2ce491b6121dddb8e1a854fe7e8226fc98c8a40c4f229ebd92e80d240c0c655162e39f6236e1371cfd6d0366cc4f328eb49d252e04d1f8d827760fbcbcbc186053a7fdf39d7486669ec6a6c4594ea1c4a6ac722c4e649653754d75877a222c21a1da12ab8bdad1338020cf180c6ddbeb1b20e3088361bf77aac91f926875c772acdfadc1ef3dfbdeee0ffb83a13b38714f6dcf731dc7fd97e50c2d0b1880881f48c1d9545d54dae3057e04c3d3fed9996d8019e36078d6b33cc7e919e096129682a1adeb87c1b0d773acbe011e080243dbb22c034cf6e4eac78f1c220b0c2d03cc91b2691960d1897c44d33a91bee59d2a9647a900c38101ce25c954240b1c81a1ed7a83c1d9c01a0c0c702b9464a0d2f02cc77a31c0cd2baa76af556d737e31c0f8f3aaab1f3f4a560a8cc0f09b655886f55d9737c1c53f1ddb83ea1fcff0db5d737cad19f58ee4bd61ffdbd3d2ac886fe0047c6f77443d87872b4228ee378473cc10665135fced835cb60275d7cab7369593982b5fedbef806c24a62010c808b82178ad86412181f81553be82865b0484328b150c7b85087dcdcf02283ca1ae126e1a5246abcb872924399986a9e15010c20f156ee2bf07db7f4be81b0dc1085d52e4c154a818530374d655a41fc4c72cd330909c3854989908d006f355554b9e42d61c2da62cd44244f74dc0d8fba8748c03d83a3431639ae6b7bbf084cc2242e18a426464fb040e2588d52924b12ed2549063b5c7bbd800c35d81d1f89329414ef0f32e4ee1975afc345fd0ed34d4024d03ee01cf7f480776da7c31fb994b483d3d6b5bc43cecc53b255edc5228e088b3ba40905b3bb7c08053eed1f48088345d59524b86bcdfc4b0d4887cf71d665b719fda0b5c372b381949b092ef0df687b0259c429ce2033852c2228f08763f1e6e12b311c6bb485cf602ede57cdd3b886fb431d5348a48732e626d423d31d5d95392cc46ea4f342c7503322828ced18c953ac6a914091340f332aa29eea9d366e35c690c65d5194975d769349c10bd915312c650123dc9571a1abda15e59cd22e7f7ca5c01b8a2349893c100bc2628a3794c4c98157518908526ae22d8e307b7ceda864bab75bb9c44252aeb3e3475baf1667f5b6530f3324f19e94624737539ba96f1af5c3cc4a2a490e35285af0ef924b8c742960a887916159ff351329f30ea9ffecd06b85bb881b99da8f79c1f56e547c59b48b9a0b0d40b3b25fd9dc0daa9a8af1366f0953544c42854f513259a7d35066a4df7f3baec50f4a9ee985f8cb4903dc2f72f5a5ca004dc3a809e4ba52421684c5faa86251f3d89b6fead7be7760f85490baab0f5f44477c3d094a5aa7533212a9af6a2d659672639f1ef203cd0ab8517a8f98215e9831a790c527bc88cdadb97b0125304aa0637d4e2be7b4b27b96fb81b67ea8a1fbacde6e29bfa75c168f78f7327b472f49d1e67d8d5fdf63ef287f90b1ea5bc484fa64580818bf65ee6032e2524fde877a79c1b7d5078a8e99e4304adfd12288c1378e45259a4df8daa9ee4281a3b2c066481029ca37d1aa1bb6804ca815fe9ed2ae4795c1cfe83165effbfff26fd06609aa9cd2f8a3949a1f116ffe207d31808a070cc1753588ef1c2f0d162316f82e8db24b2bf0efe2354b63389d5bd1f4e6f4baf2185ccd39f267e5daf1e4b5831ed5339a6cddeb5ef21c5c5cb1f059c4f32f83f83ef51e665f965f16cbbb724c068fb32ff66330598aa81a65616f16e39e88afe93c893244d1173709fde57334b9fc2b588cbccd5d7e86abf9d3dabf2dd63ea2f7d9a50c16e7a77f2ececbb9e3dd1dc9e566657d5577a173c982c5e80c57e769cb3f28db0fb1d21967544613af42e3733e26e7f16cac74ad38ca2ecbc07988af53f4b8f0eda7b0776545964b037f4e83a9edd5bacd87c9b3d9b8afec91ae8dc077d399d64dbf8e993cdbdb5cf6b5cfc9250927cbcb70b29481ef5a51a5f2e4f16cacb0598ac0b769c8eebecec6230baf467436557edc6968b5f1c7516f5e21ffd69a4dc5571dcb65727bffa53ec7135b5ca735bd9e2632bc5018ba7fac7d9b6e5676bc8f7554ad7d97058bf3f26e35b7a28c12b49ad37d3c4d9e6ff887becb6693513ff49f4e1516d73491615d3f6fb3d82a9fb7a133cf838cd288dd7a9bc5d5deb7b32c83b1f6f3b5f531bd4ac20cd1d9d87d059b5dccbaee13e82f45d0fa5272159f4dc3876d827dcf4653dbc33da175c3de15853e2aef7d2f45fe5661b0b3f3a0f0ad7b67a4ec68ecfe2057cade3af4b70d6616599fd731a85e6deb1bb732399bd06c36762f42c7b5d63e2d237b4ef1f44ef7da0ec7e564d947e3d1339a5ee5611635b5e1e47a72cbd7abab74bd9a27b3ecbcc5e37a31a26136aff39f5aba97946fddbf317fbcae46eb07eb4ecdaa9a3b8a2fe76e34798883c9f2af707259ad57f33c74dce7777af02274eca770b2ec2fa7f42958dd34f17eb6ef3edb436ddd349e0abbd9f456e99641f526e642d54fd50cfa411eacae9edb3a4c6b1cda1a7ca277f48e49d1e33ca3349ccc773ee2a6476e03dfbe59afe8235acdbdcd4a9ed5ba5e15f89485a9fd184c972258e99ed276345efb7dd2d652d9f327b6d03db5b2bdba9e4dbf77e2bd5e8cf2809c97778e57a2c9651eb2db87609558b376c78c1afe18a799583b491265f3044dbeb4781cf6caabf6afc2cca5919ad1f11e9785ef96c1ea6a1dfa4fbfce34d9e6fa0e5377ead9ad7bbdc9859cc77a3f37b9e96fe3056672ff46dabf77c0ee7f6af8ffff0bff6ffb1ef2f21f000000ffff010000ffffe80f55dfea180000`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd5b93a2cad23ffc5556783dff6944ed6e27e2b9505a114f3d62cb69c78e159c1a688ac3125071c7feee6f64511c45bb67adb59f773f115eccb44051546565666555fd32f35f1dc77f0fa2ce8f7f75e0df8bb3effce83cec83207ef002234166e75b87f3c2601fff5463bbf3a3d3f9d659ab9ed9f9d1299ebf047af6e04ddd5b669cfde68380fc5aa9b16e777ef80942df3adb584566e7c7bb8a22935cf1a61a057e56960da60e32a3bc74f6e5e2f2c50c8bdf6f6614374ac3adc61babac8d3ffed521cdb79cd84eb4ef7ae03d58811e78214a1f4e91415b016ea9e3777ec4fbc4fcd64e0936580546e3f683157cf702033f15cc7de4e0be74bf771f3bfffef7bfbf75deb30efdebc6a77f3c84aef5109b5e88d4d87cf8dd734ea6f13df64204efc100c15fc38c5507e1a1f2b311a816fcd6899cb3d9f931e80f7adf606cccce8f3e4de19fbfc70e2e4f53f4e3ffeb52ffaf3b7ca3fa3ffafd1ff4e3f7a7c7a7dee3637738543adf3a4ef4bbe1ec8be18952fcb517f3d0f9f138a0e8feb70ee7079d1fdd6eb73fec0ebf75d6c8f1ddce8feeb7ce0a7fb0d7eb3e3f7febec1ca3f383fad661c95fe9f7df43d5a0f06fde80daa86f9d6da5b963e466adef53c3c76f9d310a7437eafce83e7eeb8c62c783466c4dbdf3a3fb34a47bcf03eaf1e95b671dc19da77ebfffd8edf79ffefdadb36a2dfa5814cd7bfaef6f1de6eb45a5df7f4ffc24328dce8f7f50dfa86fd43ff1b0dae6be5d606a63d9949eeb3cd07c8d0859e54e26663f55dd552df3b7fcc16f7bd337cc7df49b15fc1605c95e377fd303c3fced7d1f78bfe981173ac8347e93b62fbf45ba6d7a6af4bd5d50ab5d2885f61f9def9d7f16529b49465d68b5c441c66fdccb6f9e1379f8a58a14ffa3a323c7f4e3ef58bca05d7af6d308c88ff7c4d7a3ec2752d320214533c6c63f63d52205f22ec3d53f2bcae11f1d2d8dcda8f3ad63eef7c11e7ebc7b71e7db67d43e4606fabcd42932ea851cd5d703647aaaff10c57b5d8dccfa734fddbb9a1a9b11bc6eeee161f0a046b841c1c37bb0f754f23b54f7515e200e5cd307110c1e9c20891d6859005d098173b23f0fa04bc8f5deb4cc53d8f9d689823d54074d09fc43f6cbf12d7835364f71c953ffcc15e83f3a5af2ee006d73bae91ed4a4075eb837a3e8e19d705c71c33a3b59013f561ddfdc3f2007f7076e9827fc6b9f867150fc7850cda8bcd09d10c4a5b836aa0f8d482d2f4cddb06b57b587063d187487951b083961ece8e59d77278cba7daabc61bbc67be5ca532b85edd035cb2bc78fcdbdafa2072dd83bbe75f5c183a639379e46ad0ff5c08f62d58fb122be7c6cfaf13e08d38743f73bf59d6a2970d1afe6933ac1db9e3e58ba77ab0472d45b35688e95cd72d70ae8b6a9bb379e1b7bcdbaf1b83ef26d8f23f5d6f3266fb49438aa7b23fa95620fef8e896ef5b9ce5d978f6bec76f1d843b7fbe421d7bc3564be13c5e6ad0f64051ede1d35be516a7fb31191add283c7db057ab71f0fbaf4ad02891623f346811845372b80e7375aa0abba7da37ac30ca307d083c1de30f79f94d3c3e493125660985a7283d171a92b6a8014b1d5e88628043e4a5b9e3a9911d8bcbd57fd360686db6492693e8ad2a8fe92670c2a17759e6db068fdc5bddeaf5c545f8b6cb55bbbaab1589da39a0cd4e4971855d4568ca20b82d50a9c065445fae1ea21749d13d80ebe1e1899e2cf7f3ea891dfad5e6b6a64f6e8e69dc77eed8ee3abfbb47ac736abf53f7c80e1d4b82e1a7df5012ef68e542bba5d2408e34f4a1c9dbd7951e2232aa6f2fa8343adbba1e9552f4f1efac4ead292f77715050fb6b9373fb7b5be68911113e87fc170bbfab0a543cd1205d13d358c6e170d5d2be3bc4fcb3c44b111440d9b12dbe0998db1571d9fdc3502fd410f3ccff43f313dc33d6e6a7611e9aaef378d52a291e0cf83bed7b10814dd5335a77619a97ef55a7322538f6b77d2d85491d5bc952be0e2a66eabbaad3e13a552de0e0ee65eb5cc877dac0787da9330a95ee6d6327262b376df8b89d15cdcb20275afdbf53bb9226fde8aeaf7cc5368ee1d42e5cafda056ce6b50c537e378afeab576051196a4eaad3040a876bd0fa0577b530ff635a234ebda9befc8d4e366d7f7890f73cf831a079ea3b73dd1ad7d90846d4fcc9313db41e0b63db35aebb274cc4e6d8f884cb4dc8fedb6fb61b80fde1f90aa99a8ed31ec54b4dfd655841e90e327a76a81487d37f74e50bbe5f81632df9163d9b5912c1756d55bb0c26a12374afd1a19e03a36a37a6da445e6c9d44dffd0f628f19d5a5ba10a14d43811b34ef6ffa1268b890f3db34d958812ee61f0f01e3556964e26ef59b528b00a65056bef6c68c848c09f876cc5437ec6f9d3dcb8287e3fe0c6789971037f1ebc04c54ea86261c337fe4882d834b0c651353c8dfb263cf4cdf8c18ee3b0f2135fe74252dcac34f4e2de831ae98ed3fa04aee8ab4f404d06fed5c7d1fb813cf3cdd8c9db08d365b80ff0aa179e25fb62cd1e4478806faddeb17856d7f1d982fe214afd5885f1273c5cfe7ad0f12e48841cdd8c6e2ffc0927c29f52d4097f15fb02aa76dc133ea86f1434aeb3b9024a65cd497c077672ca5f0f49fcde7dac5f3f67977f24d91bc0959d6f9d83e91bc1fec10a90ea5bdf83bdf5707a20a658a6ec69ea6ba5c200a5dd1e35f8a434ae1a8cfbaf96cb2dbe1b850bcec857cc5f29fb497b817d0c3f7a30fcc833a348b5ae35b8e04ff8cf4ae2e82be5c27d704a3f29483fd8a1aabb374a3986af5e791ca5f9d2a8ed2966a6c8d493bdf9a03986b3cff6d2af168df7aa1f81ad72ab50ce6a50e157caf9597d4753753bfffcbf727a40d4079c5eb8d6679f271bc6d78f12fefdad63a8b1daf9d1317b51cc31cf7f70d3b1adfb7c288ba733379b23cde391eea14449c7b12ad94861c61f1a3da014714071ecf4ccb1a750ef6d2c8d962dcd1bba8ab48ab8997056c48d25fbae65ccd051915689c1768f1a33fa63998ee277895ae0efb13c52a0eeedc8d144d457447eaab1428ceb76c6c3f74db058a6cfd65bf3198bce1c3b4d38568814b18b349f7c1f97db58323d4c0c56480c669c68bd8da5b0c287c64ed3a24dac8db8d9fa43658558de1e2dbd277ca8ccd8d6d3b1bc9df2eb37661ccbd27c6f48a3056eff76bcc67d9f94dfd37b7c6a886b8a9b450b46b0d76f9381ad893bcb64bbd1d2c5bf17cc6e4a19b3f999634696d8edf635f1986c249ed2ad60be29dba849bb6e68b282fb06f4db8443c60a0ecb34ffe6e0a0b03b4b95569622224a6585949bf1c89c6d2cadc759b2d8b714f1843411f7f7a038976d2de8310a29531a236e52f9de767c3666f3aedcdb58e631980bacd0379891c531f87ea879fa82d995752ddcec5d3effe6a868afa0795d5bf7d7812c9ee4b7dd31a33bcd233d1d532abbabb673af88c25115073ec7224a4fc7b631e353559c770d56382f9931a54836f057a230e34491d6148c5df1fe6cb5507c2191d3516038a33f9afcb1d85eb4255098d11fd9d8f048a6814fb9889bad29599a53dccc8e3566f066b0d3d428e91672ac90eade30c5632cc98938e9aedebc69ac6c47c3fcdd62ac45e5a03be3f72593f5857b0986dc6c6e6b9e81cab2c00f1ca633b37d3e70986784b3ce4e3f94edd855c475244b73db60d141f357962ccd11c8e0525ca78a38a56afccb0a09e987a5f8f383b61da3523eb27a19cb9daba26cc9c073cee05516bb6889d647595c238e990c3966ded7c453a2a79c3577648bdbdab8eda44fc9ae37b6657a67fd7ca32ccec9cad4ea724f07999e46dc0b55ad2bc4f4dab873c3198ca13e3d1d0d39767ad4592151189bcee8303fe7b45a6c823923510bfc4f3cd97a8fdfe1f1071ab28aadcdd688b19007fc8e6927f107353d5a8a34cfe8cc6e12811dee15b11fe0ba44e4e1b17346f6cfed3851c56346f30d4a1589efea5e1fbf0bf47987f2d2baa74a3ce8000ad73783fafb4126fb6ef6aeb8b6753a97ef75204bf3892cf1f6ab85eb0d15671cc9d29acae467fc0475331b442fddf541f379a4f9bca6b142b298c94929fb6ebc12a884c8dcfee7b690b9c060dc05b371e7ef30aeb335ca69ca48d5f79b74cdbfb5896a322ec5989eaf4eb57e34c4b4daa0216e6b8dee94053a41d934fa8d329e2f64ff25c06d54c4a3f5fa4159f3a66e98c5a4ee623c1e7f427f98418b7c1e2d83719fdac7cd9deb9e4019d21c747e0afab368f79fa347a12b5e1da02f15b6d140f1a6914eef16cc067dc8128c01d61353cd2b75cdab55e72bcd4351ce57ef155d2e4bf3b3ca4e23d021a5ecae7259ce754ea993f01c877ccd1be2794b053def4d69ad27a41c3bf4b86939e7c8e2c0e526994c319ed2d5bc95b598c917f3e6625b6fc782dd590b3fefd3faa0b042ba04bd0f7a9019e3f96ad7e3c11ea8d681747f0ebaee89b110293b1ab6eb50fe09ebcbb7c0329c81fcb63b653a881963bdf0ea8c0b5d00f4025d55caf42ee1c5019e3f72d9d6715b36e16b3a46bacf59dc1b85e99dc94653b6813f329d21a5c7cc36d962fe4b6571e02bdb71953f888c039fe2f936297898b9d02120ebf8fbc0f359bd83823e4bb7c1d7db63bbeccce2e1e22dc27a08eb6276106aa5de68f405e49e023e1c82cd037ae8335a35f5d482f42bd335ee151ab6c859ae97a539326642aa3963b47409ff0afc41a541a7e5f31be8212b5c00dffbf1d31264dddb5860ef916b57119550f37616e755e571e570b3ee90714616b60b674668b016d8713027be6874f7a8b1425fc0fa7894e0b25660815dc5312390af27331d253c3dc4fc05cf896d13eae918a9121fc03c69e0be8f81770fd006b01933f95b07aa78724b39e4cf58c666f113c70c661a95c9d9bb44b5d898fd05698705b66ac937e340a34fae9e8e3f5416450a53cecf7afa15bbb4ac97d8a0d7fa58b5df729a58dc34b311c14e053b0b689b3fcb684c6c13a6e45d3dc53677594756d65abac681f710d258bef836c784797b58ad3747aa68246fe2d035c4137e9ebf87c76c57d0afe813c78c9fcc94b214d05d64ecf367250d2f6dbba2dc8c5a94ef3c1fb8a99068e234d569dbd6403732d89e029d766e1b47951690cee275cd4187358e836de7aadd79396e057dc79e210e3e38c6a62b742868f39fb03f9b7403f927ba33947d8182b9a44edf91c5b1bf649396efe5f63f8d2899b6da6dbe5a9b5af96a5cb5e99b639cff93e9e939e3edf1c9108794b21d2522db8df0bcb11d0fdfdf828befe0eb999ce43c569405bb9cc5fd49046f1a19e2aebd8dd83eb8e4758e1937da58bfbefa2e7bc566adbcab5c8c23f4e1a61d5b966346a5beafce1595fa71d959a9a3759f6b7cabc11bb34f6ddeb671c236c186b66ddde56d839d3c5eb481455e8d1e2d7cc231e3bf6417d7eb1a59551bf9f6b736d6cfedd8d667785e0a0c67f092ef652c992b73c875dbf913de69f24561535fa56bd3d67a2de738f26f4ccb12476c8e2beddd5c976383f96519be393740798e25eb835e64e9927030d89db54476ac4d0a5d7bc923f8df38017b65e9e7ba590915f1e4d66579808cd4fe33f34c78e59b35db4d610613324714fdcceda46bf3de92197957d6292ddfc3dfacdb72ededbae49d59dd7eaa3d037dc20a9e2c0991f1122caed2d62de612ebe776e435e752b3472d3ea3d1857d8b9af3acedc27e4dab2debfc397abc7f45b6665f5cf765e3197e269bf95aaf95b7b12d2d24953db5c71bba17f61beaf5cca8456b5f9a36cca46633806d94ef9f5dc83ac7963a8decbbe235a2d69bc3de56f1bd729fecb28ebf7d3de85cd5239fd802a54d23e3319d86b0ffb574bb0785459e2a0aa99e8e1c8dee86062bc4fa8c1f704e9b3ec2bc4b973aa5d007789d037b58ad72dab085aa7304597366fbb60e59cbe172f3626d5beef90a4f6fecf043168f8fd8e69c4dbb5a8f87fecb9a782ae8febe3d5ac43e796cb563daf4578d872afa01ef0db5d856fff57dba6eff5fb7b3eafb48d7d6a19feba2eb3aa8febdc27ec67355dd7eaeea9f8bfaf13c0d75e7363ce3b4cfc94d7efcb5f9779ced4b7d32671574689f2bf07c58a367b9df1f10fd9feb0e5615854829e7f6e8b37d9f5fe6a35fe09366ff5bf66c2ef5348c87331a5ebe3368eac2ab7d7bdf366c507c8e35ed6ab3cd8deffdfa1e0de3dc9a03cb79baba87533ecffe35f6736acf6afc549fa34af9d81673c14ea363a43936990fe0fe2658546881eb6bd82d0d59fe9f0e1c60ee0119551c61960795f87cf3cb3e516a1499fbd809fce80b7e51cdc2b96f14dd7ba6bfe21bf5f8a3dffdd11f7e1f0e9e7b74b7d77ffa35dfa841b7f7fc77f84665cdbde21bf5dce61a35a4bbd430778d1a3e52cfcf143568778daa17cd3bdaea1a75b5e8dd35eaee1a75778dbabb46dd5da3eeae5177d7a8bb6bd4dd35eaee1a75778dbabb46dd5da3eeae5177d7a8bb6bd4dd35eaee1a75778dbabb46dd5da3eeae51ff75ae51cd4382867b14409b9801274bebb1de5b237093d2e8c19963ba70140d30d41738dee15803699e90ca120fee528eb91d07c68c3feae7e0b0a401da6a7765078e82a883420f3f30bc75e61eccd9da5d79c6e1351d4fded2f1eb5b7763f13b7e2e307dec06254ed713ee6592ace028b7fafd0cae98b9f6b0d3c8608554f3a6942a29001dc010680c6741bcad7b0632ea6e27c3f74d082e217b5984634d7274de05d78fd85668a1389a63ac30d4a4f141f737d66214cc394f890d865b2c360171e7c2f499103716a45336d26670449541131907609af64696f88097002227d8063b4c3956a065f1d4057a810bd68e9d52004b03b79f0c866b9f959790f439737b025abd7af5bee6b0e10c468beb077a9cf177095c0220b1aac4236d7bb4141a51ea4c7096defaa06d87be46af0f9a348eccedb0afd39b54a19f0f3a1bfbcb9e7d84362fdda29d702c0cd0620c2326ee63c880f688534aeb71b9db58d69749fd3d806de89e825d6b88cbd5d9606c5c46d9569f0b4f0015009728a374c70a39c676347a182d991c7ae12e7257b98db43e2bd29c52c561c24bf354eb7196ee0947ad37a7f4740cfd7a9449196ec607008327df75355a38c3b1287c4b96f850a79501e3f3039dddb5d75b7381cbca1570630bedf0f8cdd614761f3c822b4498c3661137999e755a48b1ab939b1d912fb6e3690625b1c2e2bd14bb1994c7915e970217267866b072c039d066fe00f017cd1b52dc6c0dee5367c577adbc9f400b8e11ce9c7384e3cc1dc8c99219a325e291dedb848c4f2d72b70999b6914cc735f95eb002ddc6b74b667c7dcc2a70b49c264bc2d37a3ad62429ef9f8b6150b96b1386894e9f2370f103b74a8060e9de3451e85dc607b315715518ee97e4e81eca28929cd4f928e71b702342ad47c557e1fed89d69646b7494bb3c14f4bfe483f953a5afa4ddfcb07481092adf1884dae8aa6b0071cb1825bc64637e95c579544211f8023654d135753d5c710bc0b2d032960d5d53978712f25fe8bc65c5fd30e7777057d4c17d00f88d5d078ab8de2be26641202399fb0d76adb9802185dc242b5fa5a39edac870da74458d372e8ee6aff12ae66da60ea92e6038db23b8a2125d62dc846cd5e87609bda8d59ff11eae7ff129746396433cc695f1acc07d320801f060b2114f9422cd13d04786275c8c71e65ed09c070814cf079d80fb8da1fdd805957ecedd29cee04e2af7f01c5dce916e3156d8d5529580efb2f94703def606a05f68455a7d6dbca7a0178571edddedf5f15e147aef988f7d58857534c7a8d126eb5deac2b860da1563528579800dc35ed51d6879755c3f87bc1672ce700ea9bfca0b35d725a25bdadfafc383665a17d3b02693758865051a53839f605a147ae693ef4c1451b10df14435788e7c9fc09d007a3f0abe0a775934c66b6acec07ee26dd93ba10d3d8c75f684cc8c47fe6fe9b7d9e6ff4ffd4635f9f4ff8e7e0bebef000d316c390b8bf037c2a30896e4736854b5600e8b7a7ca49ebf028b1afce8f57e74fbdf9f9fbafde7fed3e01743460fa8dee3df018bca9afb6b21a39f86c37e8e607a7e7aa61efbfdc75e7bc8e87a51d2d3f690d1d78ade7151775cd41d1775c745dd7151775cd41d1775c745dd7151775cd41d1775c745dd7151775cd41d1775c745dd7151775cd41d1775c745dd7151775cd47f1d2eaa7a425062a2e0fc750321fab6635f110748f7ca10b5ea8ca7f4d9ea71990e7d08bf67881c840c8c97b47180bf3a7b1a2c7b70ae3af7b57364f19367ebcd1deeb88930d90a9b046376a6eb9f1b0142849d420881d0767e45b0033df8069ce169f4ba8bddf9e1ac09701fc519cbfaa09150384ba7ed1c26c73bed8a90773b76faa1d2533fc771c0f90ce3a158678710f232602cd7917d04a1d71cfc1b872e01d77d6ec16c217436b8cdc359ee10c23be1328ad7a5380bb053e890ff5dd2e860487ca865ef258ab43960fa315cf3fc709ee1af0816c2721ddc56e8cf965bd4cf998290b1b2b3a61c3fb2449577f3732986c7580b1cda82e9139c5676b69ed3a1385bdbad2315680b6117c45304f8279dc5383772f608e303dfd890770bba5986b406ec92b5a386e31d09b5857f4f15a4fbeb50a3fbe48c96e0d82e42f6755f34165d84deae84ffdbbe3195737e71e0eaec30c4a17067eb832c8d1e659177754f38eb69592e1bcbb163e2f0cbd9f92f39bb1376eeb1c4333945a8494e98f29b4dce93b33584483f132c55aa48d32ee045741c72144232ac2908cf9ae3f0b271ad9c35937ac837eb753321c60d2e5d42f75150840c82f179f384dead31d23dc029013eaab8d7469ff6309453e38d9fc059270975381920a377754c02dcaf49fe9dd11fa49ff9f3b02d4c6249c368510fa134f2aef66b16c3b83443888c15964f15697d2667da353abe3a19fe32bfceceeda9452103a57cfdacf173e5bcbd7226dfae638ab3dfbaae59ccc6c03f94e28c9cb904f8b269a4cd5696d61b637d9963d396ce084118eeb2cff999f628e1d9e18781751784dc4307cd7189acdc0a03f47ce066cdf1a2dabfdfd4a9ceb5b3ed6ae8c961d7988dbb757c09e115c6fe20e30d3c961ab536143c13b6b42f907b7c3f0f6d65ce3679889dbec11c011f72324438871ffd91f1074af4994015e1df00cbba75ad05e1b5b79e405d3e6fc7d5642181d64718f72c4c70ce83793f731c0ab4b3fb74232cd159a3a7c70dd099b13f8c991571ec2ad94ef9ddae908fa325a703e0e308d300b01eac70ca75f112c1bc371615084703e3c370a7e507e7c0f8eaf4d0d65f2629b44d114f103a70f82e61fd765a49399620eb673d241fae732cf7f85cd766df9ef10830af8087e398b9a3d1fc200bf1b57138960f212d0087e69b0df026fe3b7232bee42af42fc62f0219d62501e93dd0bb73a4f7c607cd5fa35f09c904a16cdaf0438cd386152abfd1c405e56344e6458c3d2ae4a6121a4d4e337e9a636ccea655472d5ac2ef2ed83565e6e930249bcac34c9a33d2f78c0e394e66aef94d3ea2ae85782d6881c77bb2b635f6886563c98c3f96aebd13a6e35c8e307f09c27c45303119d6735bc8dedb1b35dd089e90ea34e88e91b3daf653cea9f08d1f3f95f4e962fe5dbd4dabd8a9a8a16f719d1b715de83ff26d4a112154f31430398eec0d5d53c8427271ced85561ce66e6dbdd64e570cc00fe5a5c86b7732af429e8bbc474c8e65332b60457530957d4c05f5de8ad4fb0a63b347e791385b30e769eb46ab57f4add5184743e429f881d91eaec0aec8481c6ee2c2daf8bf04b262b839546af6d2dd795b36cfc5beabf08e5dc989f36e4bb559d7bc197855e2df9cc922194d54be034ec32471ed56424b71d317d1bf72e52b634c6f702bb09b425be11d937dac3a0e376bf3a5978414c2f29cee8f512589c473d56da9c901062b8eda0e37058be8fa09e5ec58f2c339b6f1e49082ffc0d93cac29ebe3a2307426f69feea51dbcec15e10018389c395557566230dc6bbd41de67321a10d9699a554cc8fad21125bdbd2cdd270bc3a6d38b6c15be19bb13d5a57c2a63542b9e2d0699ff37fb1ae1ab3387c2883fd183cf29be89d41eeb7826d458cd3f7d087b221e963eaef577916d2da40987dabc0ef1f83f9ce136c9d1e26d57b60bff2ac40c9228a381615f6b32ce995d0ea157b3e0baf06b62a7e87b1d04491785b152b367a05c7bf60656b81aaed742de2b7102cca70c2b8cdc55cc2823fcbe04d87673eef2d9839d67310ce196c75b0d35e333b2de2581cfecf55c08fc4196c15717ad6e87ee64b0034c66b8e8246652872b2a65d6dfb27e2b7937d03fc7a1c320eb395258ba750a577b91f09d6c93ca14175fdc3ec608db93badaa344f49686122f30a7e8fc7a144396fb460843992a5f541fb989c3e7927a713e889793e5eabb70999977864b05308458d65fbd581d0a3784c70794c9fb7490af5e6634474565e1efb4bfd44c457a7deb6f45adb727efa89f05cdb681b77ad6d39bf38f208fba29075261ea71a2f55699b87582ddacf623df5c839a750f30648c7e933e6161e77e22b83bf5f59d7d6ea14abbe57753929f87edaf0a5c1752bb6ee8c0248b901bc006390db9ac5dcee470e5e77bd0487658fa411905609d01ab789191c744f3f64bf8748f315a4b1c3a3b27d7696ccc8c9d223e0bd0a58c3408a9bb3226d8686c47bcb37cb81141db9fd09e1acebfd1c3c719e4d19b311ecf9f48c9e9e18e755a2f5e6fef2cc1d572fabc3ea6547beddad7c9b8b38660ea14923555c93f5cd10f3fba207e31d010ed8d6fd35325eba5d8395e357663e641cb72a672fb2788af0de438f3f576d2fa387eb067d166ace98c8b390189e10c11a8cc860299fc53a3e5f0b8cb14d0ce1d16bb6e62880bd0008f50beb92b3ce0e13980b205c2cde0fc842ef837f586cd0d3010774ce533be57e5095b6dfb425d9abeb6a48b5966aa56f58c4cd2a7c57e7c38863a71f197dabe5c9fcdd1aea7273d59ee758f0bf1252c59b7e28523c6ca485c1e14f655ff038763e803d88a53bef2a9e82c0c708c21ae3fd26321f2a9251841b5e308a638872c858a8c223785dd53eb7827fd2eef65c8f79ee259c96f5cd87cb11bc07617cb9476e1a1bd510b8dc673609c83c3b38bffa307e23a71efab6a077827d4fa46c9e7fdf8ef1374186b0dd74ab4fd497fac360bef4618fa2f4efc2fe1ce2e0e322346e85e75bd762d6a52fd8654a1e08257d110e14cbc7df54674183458def6e84f5fd8bed2e52d6fcbd755778b70893dae63f33afa6d581f52ef6bf13eb618e356f95f0dea9abd356f00b34c0297b74b2df91d11aebe704f60d77f95eca147c66ed975cef647ae8687168fc73d7dd801f4f57f78e1157cae5155fc2d6f44b45aa4892ba07fa43e7fb1ad8ef16cfaf3cf6c9247517cf715ab91959a36e8b7e9e96ae8d641152b60cde14691370f5bd0d68b3a7491bb0dbc9de9be5cca509dcb7499910529f156923f0fe4bae1b47f6cfedc8e1707a2bf7624e5e9ca94b1fd48fc892c95af8e75638819f697ebd287d202fdb4d74bce66fe28c3e5c548675cf68b220fc569c81bc50b571afdcbfbeb79c8d059ed3c8be0a4e7f90bfbb74f90396b7ad5be5bd2ff029843d867494302f8c920dd66b83178d1d9e95ad05bce24258e3ea375571d83558142dd11c6831867d0d3c7f3203accff2fefe8a2c56e7df9f394f32b297b70bf6b38c9970c6f6e8f6f9b47a19e194862b67e4cd9d7104a198c9394e22c03c2a643a7bc1c27eeb245c6c47c39fdbd191f8ccc2bcffb3755ecf5336c2188add83e69d0630e7aaf4e060d027a4b046beae429a34a6ccaa9db1a9d118e84e684cc247e7762ab6537681c24e29485d6a14fb3a353b2497d3779005bca660f07c0363972c9810db41643d11bea6239b84afc6291ec978802d4019d21adbe8cbf3e4b8c2a9d046e985bc834f36f1e1561ccb21673cc5de0db69d183887506c553c21e525b0e6d2aad42d8d71aac84c65bc8b547ad836e4496a518ecdd329addad78de28056c4d301f65bb279f758ec1deae9b84a07cba06da491f7b2b2c57e0fd87124e568fdfb844760ffc131251efc274bfd958d59b18fbbaced31377dc66bbe70738529ed03d0d7f9f774c7c6dfd1d3c1ab2219b0ee84740f841f5c3cee9a3b3f283317cb789ed682a427c5726434fd3f990199b74066ddcafc06ba061d96aef09327ef567814eb58e2035eae956b36a20d2975132d1d63d9e6c06627691bafc978a57e685f515eefc13cb50eb859e6138ffbe0d8780db72073c327a97448eabe91ad8a99aec97de0191111dd0572b669ecc18c86f539abb67e03fd4135f7a640b770d918c0992f7c17cf83aa38e82ab5949bc8fbfbbe47f628f271da6436b8e2d4f60ef2fe56ed0bb748495609fb3ff76498f3afda5cadb10336249dea26e3a15237e13e91752b49e1baa98ef5c8c33a6392f5c1a4b08e7b2cf658de26909632df63c1f7f33d80d51b1771f95aff2580bd987c2d7f5a55d23bbd6feaed2bdace28151dc03f65dfc3f560bdb77a9b44dc34df9308aa7b3da7d5f658ec61bd3a630fe888f7435e26a77cfda6f93c49530a7115dcebf355ce8b25ff677340d1e68abe6d4b5b54913b92a2e82b694b618e28f4453e2ecd363663467c4df7177a0edbd9788faf48dde292be52b7fb4c524ffecd6903005ba67fc52db62c977bc53e53c3c1d7930550df9f1e9f286a38a0bbbfe615db1f769ffe0eafd8acb9bfe4150b71fda9dc7ff5e9f1f9f96938e876af660ba814253dbd9a2da0b5e8dd2bf6ee157bf78abd7bc5debd62ef5eb177afd8bb57ecdd2bf6ee157bf78abd7bc5debd62ef5eb177afd8bb57ecdd2bf6ee157bf78abd7bc5debd62ef5eb1ff7d5eb1e501415ba200fba0cf48d0d2ed407edb9df08192dc5b11c0f8f3819be0832e4ba185240311082e00e632a0cef8fcea3c1fbe10a4fe899bf03fb9c9e0a7c08ca7fc04ed9656002085ad222a914280284b5149157187c1b000325158e1e33f9b1c2003a00168760940ceccb91580871f1a3da0b1f3ab4fc0afbd9583019d7f3610380e5a3f4ab6ecf4fcd61b23ad04d6341de5ea0e2a70d827ae9c9c1e652ef051d200fab7076f6d3ad8ba421f3bddb053476385179cd88115fa18dc23ad6a0e2a70c88603d633f6417746c4d16d1a6afe7a8e81c50868d6daa7306f5b5e9701076becc936d89dc56540b40fce0130298f642ae31745b231a08863fab5765c7514f04b470e3e771095d69c229e00c89a3b47029f65dfe962b06a155cf1a1b2d31480500a1c4463800206667c18d23c8543798e2d0e9c238e1d40e07800c8a0fc90320f44acd3718841bc23029c25e395e549b75d8e093150a204495c0fa4cd4800a48640fc3605c04f0cfcd990c0fbf81016511a1d45b503724440945f0da65f0980fd67010c1b003dbb185cf208cf1829a3ef4f48fe31c3e314e48e9106d34f96cec5816f13ccfb58800e6a7d5bef5569741558583dece51c2e2a0ffbd1b0d9665e1cb8850cbd04759a14e36163da2fb69983749928a09220828cef160eb65908340db2501c7a0318c0c181d90184ccb8d602febec8542521060619e4f429019075beb902367b7e67087f00d88ee19c26e06945ea2c8175c459afc7a71884e46047adb3b283f6d9046c6d9c97089c08ba04e851d261f5368a38761e1ab355231905e8a7b5a07e950e33011cbae935d3e4f91c485e8c7b556603856907febdcf701289bec18c8655506431a62fa3821f6a802d3cd6a0afe464c74e539d16b23ee43cd06b00ed66b87de192199d80b6b287283d7517006225a02ba2cb9448a3a75fe489acecfa83f43b4b3002c94430ddab002842e71ddc5fb09b2b20d7d33324b7c03208001f8700590af0c2e858c83c062e14a08662ce5ba2317664e0bdd341963641eee0a1a7839deea198389a11b9766ff104030960dae8b0d88e02d91b1eb4ff2d1e60c179678dbedaf70d6edb6732d136f605a03d6bef76f407d83205d05d02dd5426213189a314d0b7e19c70c67a91f9b300fc710236cf7b0b50fb02744f9c2baee9d66b80d6525fed125e8444513c12d8e11e80aa599fb173ce04c0b5b9ecfcca37ca7b97f583038bee6fe0bebb605ccc7b5fb1811456f05471803806c69b4772cad59c69057110834e54d969244ebaab2a70f322d80309e8a2f5048a639b76e438addab58c533a721bcee88fcbbadc8b6f5fd11f842fda79a2c64315db0bc66be9e6760c5fdac3b9a332d8a7840f9ac03d0056d59cf45beab9e6188ef900098926827eb56d6d42741c3347e66c8ce4862d953b1dffcc9c838b7e15747aeb5bb20ff4b66dc3db593f19ce5aa4394d1acea64c64711f7d67513a2197fd9dc909d09804af1836fa7ec569bbb887ed4decd4eb21c7c040d77a8009adc759527ab4642893e931b25e28da3a36667cd3a1fe6cd08892698bbcd7e84fbd6cc3a6c74e4121d02677102fcb42f087b5add3bbfa3882ed3e299cac1f0bdae4ff667272c9a7a3210780eb19971067a28b36153257a9effd620cdaca567915e66570d21c9c333ba8465f0c1acde69fb12689e0bc7563cc58022acedb33e30f2ab1b733fdb54972bd52e19556fbb98dc7419f957597742f6d69123c8319083a2d9cc9b7ac77a94be1efcf2a013124685b3fc81c6a31d831ffde6d9b39ff7ee1248fbcaafcd4f9b3d9be4d33004049ab565ebb5ce3fe655ecbdb5d0ff441e6d41cf8ab848a787239a75cf77e85f738a60c9af44a02e5549e15e34c40e66f2a8d8e0be6f34442f56040cd40204dbe6fb9be1a14e7afb6eb0a6f367560c12bf99c99d7f35539ac8c7563be6fd239b7cd38767ad431afdbb97d4b2d9d7e5291bbf671ac7d474894cf791f83aa6511253a59f35fe8c4194e28856dc68b755fdb3855754a83ce788dd8d401f8ef980467827db42ed8d5812c9ee4b7dd11fa88f95b4fdb8263806cda0743dae4e5c071f600f329f09ce2e1a460e0485606bf626cba255851d8f86ebb4d4ae6bf2bfae532004c651e2cd6d2a57c1734caedb4729e6db5556bc1252eebeb7e3a677c651e2c78832d799a63066b8de643c543380128e70d639c78133b3e71b5f7894cd2cbcb84521657aeb51a3a36eb3fb6159c1affd7ec802509ca81cbf9b71d7bafebd56e2d4888e10c2ef8ea7deb56fb4368785b17fd37f7a14d7fb5ddfb6cbd42fa18bea6d7e7fcc61aa5a6cf1b0170aeccbbd7f741f3f6f01e421abb71cafdcf2bfaa76a87967bb2786fa0367eec676b3f2c8f2fb007cae3bd4f9b5ea21b6bb52a5fb4d0a3e4a956592de7a5ed853372ce4bb92332e8b56bfbbd309f879a57a11f2bd4e9570f1eb957613ea9ecad6d6994280ce62bbcdf5cd8b7b5e04059802a352beb1401329c26af157bfb01d9f798a8d21c4150b2653508de051f6e8abde042e7319657e17956158548296483baeeecf4cbe38283b2e57d043aac15b1bb922508f209c1d4628373ea3677a9f7323bacb126c875c28d72c77a30a9226069f7a92ad3351a55d620996c946b4e913e21cdb9f53d375e0954d2b2ae8eb8d96d59bf6a43cd1a32d9d49775deb9a4ab1314bc59edef8d31b77eb6b66f64dda20d385a6b9ed05b146761f5e494a5bd55e9d315ddd9b4456fb4f53f4beb42ffd578b845167190c24ce69939d9c3d3eb742789b76feeaf5e97974227647b98753b214bb0bdbbdcc3a4e6078d3ee23dccdb3c74b56d60277dc0186a4cde06e073db2581edf2ef84c66cd5226754a34f35dd5c8c71db18b5e9941add199e2474c67b1d79d0018b7384f382f467b1e5dada541d6368039c33068a34b1e66c1cc29c579cbfa61c24156fa37fe5ac31af6be49271b0e04c80fcaef0c0edfd07f83eb4f54d14e237763ac07b7ccc5c93b68db1be25b7d533b0ebe5b2a08505fdc6a57d53e58f82af41cf7591b63bd92604296046cec2a9b7099fed7890b45c708bfd856afd5fd5e98c82cf032001749158f52acfded645f06c4ef8e0d618fca93ddc7ccfbdd81f207d247c4078a52287195fb5f362d6a679be1ec2f42b83e3fe99e08073690d81afad4519144fd6c4139ec716f97894fc6f43db16973a0c82d739706e42cee3495ff850f7840f8319258b6dc5d6b866f7559ca7bfc093c426fb2cb1729bfeae25952d122a7f49aeb2fd3a080a1182ad9cf130b1ad6fcd79157d757d2f89d04120bc5806fc5ce2f9c229750bd9df7438669eb5c3193945d0d98236234764bb51116cb6b296e15c7c56ea9463d5aa97ab38895fa019f747d53ebbad83437ae90867ce691deb458bdd72d3e62d6584d01082ac9160841c33ffeab72af339e1d75b63db66eb5ec5caacf3b545b616de02ae03818c3899ddcf95734b1ed4cf29e70b8ec837e78c5cb22eb4b8725c9daafc72cc9cc167db4e61fb67d70d9bab90f37cde6cd5a39faf0f0b9ecc831ebb589739155d51f4b91ad4db70fa45e0ee2a6d17dbff2c4fff257d5eca685347028f26db1b3c5a5f43802e1b10ba802e1baceb36ea8ee83bb2eff537e9bb2bbab8d14772ae7d1108bba439e8e306cfb7d200ce1e310d48505fc22ba58c31f5f695ba85c88287ed536c2355b111bc374c35714a9133f166dd61a36d4d7b6549f812301f57ebde02ee82e0631617e3e546dccbe478b9475c5fc3e6b43044c099e0f9a48a7561b5de3c96259e603c720cca657f327c87db4ab32b75b21a3bb40dc6f6f0df6b7d600c7f9e1ead2504eba805541f109dc19d57992d77ae07bba6e875f58c687bd1b644f9eb3c7b4546ffe7ef0c6ae118a61f3b71fa85b816f5a279688bc133f5f4f5d016c3efc3c1f3e353ffa9fbf88ba12d9e1f7b7f47688bacb9bf1cda629807a1183e52cfcf143578ba1adaa22c9af7f46a688bd6a2f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016f7d016ff75a12dea67042dd12d70daac2c3ac15684a8047c68cc10780600a20210e0949e5e891c90a58484c801382206c71a48f370dae53c3d064ed7ac9f83c392c668fdaeecc0292d7550e8e1078eaa30730fe66cedae3ce3f09a8e276fe9f8f5adbbb1f81d3f1798fe02d20b96ed72c16b36c1a74bf8046b1c146dc91011d94915b49316a8b74adaa6bf921abe48f5eee75e051b4807509c28ea29b7586c82465beb3424290b01798a2361e8e99856c52178f1808782a5d1b2057478f5854495a65d484788afbd1899db31f4adafd39b47951606ba2778798aba4fbf979ffc82e7f08e9c6256d2f8311622a7f4f51476f9691f57a6245b30c238d5e879a48854f97ec5a379f1797b42aef06e748b3a00459779e0ee8a53c676cfeccb7440984680a6a8a574dc9193ce23a0a871ff96cc182d51d1feb0e9f17f910ac8b7cfca4b80f47484f4334e7362cd53e13cff5afd51a52f652ac90972c1d34c95f8c1a6cac784267a3a8e549c727e0c69822dc3430748c10bdeb15984178c1200b9620c1145ca04250a2bf4bfc60bf333378d8d4fc6e717c6727e5e6cc7d3cc93d60a0bde49cbb4719ab4f6f574ac4952c957383ac7f4b98afcccbd3e1189ce10ebb47106449c2c8d8f808458b0ddb3d25b917706c5372fd2cbe46de8ad92ad370c35c7c675414a4489052fd74aba48b61c8b5d8f477a23fda9c20a6719a2f9b053d0330e78e93316b2c18b4315e75d4020702c46039054903b728a5c91ad4a2a49b5a4e52bd695d0561f5069985ed813bd266b124176149e4c9b9c9faa1ef5a10229ad584499bb8c1716b3f941eb6d2c1853459a7ba0fbcc1e1571199a27f7ac2fda08ded28a083a8d071da349426c54dafa66b0024443f030226ab682f438d7ca40c48e33c784f3b24d808e80318168387c366efe2ae4d8aead4f63d257c368b415d31f10118a64e114319a383d8a3d32b6dd6e3ddd8e14831c17dec49a07a9a16a7c55d248588317f587c1d885e7f992a09bde6839d0bde1c1605cf0f4cacab179a409774e904f11c70ef7183153e11f322e41f6eda3059e521acb9f813fb15e63a60735255158e09a20abb08ec3fa9de8b98d3b5f40aa157f5543c6927bd167fac3a46b631c65d16b468e4e03a2714d292278cae55e146357a3219513786594de2238fd260b6d2ac6dc01b46d257d493d050e789c6cca1455998ec2f40f358f47e634ce50b502f5c4b1cac1c0a88968f1b7f79520ed40d6959970d458f40176488e14e77c0aa7446244e0493ed47c01e5116bf018b1838352e8752bc41e3d15d9db81273a2317e536f434d988bc9be9beac3f84f7864519f2bcf21d2ca3622f6b935429bbadc8c082719bd137325a8eaa91754afa64fc279cafca7e859f610e10bbddaa4c508a04d15f326430d6d7426b9910decdf54625025336be12d60da5fe120b846a82f55d15a15a69fb8ef0e6e242cf093f15c74e357a8823ebe43c5c4441c9788ea0398f964647242d5da19708b2c8c6dfafe830f0b428e591e8593dad7be8903e157cb5a8a4b1a98c4b5eae5d2757f40df483bbe823f0edd83658fb7c655c329a7b8287f55189962ae65848efd35a6653996bf137325d9a7b81ea6057cc5699de11d75ddd9f03e2199711bbdd0bfd68f680b7b2144865bd982f2eeb25114bb05c656592c67c9a47a42a2388bdd4c66ea248eb0f98fb65587f50c314f3896317e517d5f45b5924b0248f04d6460b6653ce1146ddee28db40e4b8c2bb1f2a8b2285a9952fc768b70e54f1e4ea1e448891a33c824e3e67142998b07d31c8e6f82c12d51f9c337e7e2feaaf3c23f4c229672bf530e2e0226a98e66551c364da0e35769390392a642c77ce6cdcf9ffc7deb735298a6ce1fea01db1036f55e589380f4a2ba0963d42c9ed4da4066c509956cbd288f3df4fac9577842aab77cfdef3c08331d316425e56aecc24bf8b6a95c5acca445f034a1dda8ce7277f521e07171c0306ffcd69b6e9deac9d2106e57d40dd18ac8cff7bf20e57de5a1fe89aabf842fe21f398da872c17d0fd05c60f5b5f2beb55e93ee7c0b7f70ad29e969dc50df475648caf317916da51e238d3b364e60cdebf5f00693fd7ac6fddbef56d402c1ae1efe3237eef3b3d657dc9d60462bc05ca5893daf905e6f5a0b3e06b105ca779529e8518348f5807ef5a90e7f91a9fbbe4f151a1c8521ff326ec2f97d27d68ccabf5aa8a7dac2bb1c55b2283afd416e437d27da12f70fda0ec0dc877335d2a235596a20a31748ec80a3e96985d191b13ecbf3e47ccd271579b33aa723c9b672ad7c7af1dc8c1695fce0de1b6df8ab6ea98a6df1d94f91d9521d2f6d451edd6b8b51a990b31dee8efc9fae5db88ae2bc55825ca7f30e7cdc5fae7d339b046ed8daee7bceb309e3a376b163a16e3eb2c2bde565e771fd3750aacd12cdd2a40d9620debb0eadc5137079ca82244e4fb37f9e437cd97ea38c331e5b35c519323cda0a0fb848b65883152f7acdf36c778d2b3947121be17cfe06341d871427bc8bf33a17ee77bfa81cc5de6248f36d9c7f302bcfb90e27981f3957d01859fc8c8afc08ea07398d89f9830eff42f96a1a855c0775b6aed8f7160199374dd06b5ddfe892867887d7c6c8c77ebf3ed7a597afe3ede0c6fd63a339a9bc83edfbdf239677c8c6ff7f1b00e0e605e16f7c9602f9e5fe09e53935b6db7298b603f7506a9727d2ebf0b9095de7a4544ade631aeb06f864a5e7f81d864b16b10abd8e76f8764e50c9e80fdfcb2738f94f18fcaa02be7f093ae9fb78c3d8eac142729661999cfa606c995d07e788d012a30e3dd2c8bf3584f90e1472d60a15edb592672b895a7f317c602224a8b697821aa933360869a67b0de5cacdaf102e622cc535c9993d974e72750cde2310a2c32f319ebc6f61feb0bd8acbe17b19e6a81b786bd2e2fd35a8398813c975f856a00ec115a453ca2d695582fae7808ed0fb97b1b008b591d9fdb88b5039bf74d5040b4afde75f4e0b371e8cf3b2bdffeb162d7f3fa80aaea6043e622eb81ce2bac8c1037f86c3a9651c1a7a4b0c27ef31679b9667bad33bc07affe1daa09a2553758975bdbfc04efe3497fc1bfd1ce1ad6f9f85e20f07a3dfa3df9cd8f3d5dffcaed36e0ed44e748de2f30cf5bcab5b8a6e575039674a02ac03dd6eec1e91cc46292c5ec0a735506670c7f5015ee34127bb92432dc6d0ce3551f3e84fee48deff7e10cc019a6c16e4e55896fd4073b51c73d84faf0047be6b8030cc665121b691e39c37ce5dbfbd0b7588c2b6d0feb36bad63e39fe7c05cf9deacc76b5f2fd085dffecfb227686316bc395677749bdc53cfb02eb48fa7e772adee724d62603564cf6aa0ffe2559994f22a61e84d7ba11dc730af1a9ae11e475d8e6f6b701513913bfe16a7b749f88f1c9ebcede95c0fda4b5fdb2fd5e04eda5d24e22c7c03e5edb4f1dc27a8276833891e648641f7da7aac3b8f635f622af6d064ffc5d4f06ea1609ece7ff9a5e0eb4fdef9ad3f01dec6bbbf4ee2863edbd2f94775ef7d6dbb42fb23d35bfde9f7f5f79e7bd58fbc17a4c7def056556d6b04b7887e43e927e3dd367bb7d79df0bac40b484170acdbf54ef3ff97ae0bef99baa9cd1eb7a5758ef04fe2405a5caf566487209ccd342153c8175346d0f3ceb92f342c5da0f557aa66690dcaee54ae7467fdbdc1b1e63bdf7b286352c61824fc47be7e59eaf77c93c51e0f8fd3678b48c2cf9035403b25e2ba26c7ba24897fd9cd2fc47df09b079cc0afcf94bec85db959fc073506d67aa67720eac9a7f211f1c025017dfa2baf886c757cd79d954cdabe5778e05cbc5ca998a6e6d6e95637fab8df3263edc45763ba83cb707eda17f3fcfadfbef8776f7b1f7d0fe32cfadf5d0fa1d3c3752dc2ff3dcba8c91f6d06b3f3df61fbbed5a9e9bb894d5b496e7567969c3736b786e0dcfade1b9353cb786e7d6f0dc1a9e5bc3736b786e0dcfade1b9353cb786e7d6f0dc1a9e5bc3736b786e0dcfade1b9353cb786e7f60fe4b91d6a296e3a1eb14c6cf3bf66d88c542d67b9480280ab9940252a26f67832b637ff6dca5aa531f33d66c0139b1e3f235d03a09240b5caf2113b3694284945b419a69661bf051d6e52f746613a148e63c33161bade4d7a70ec857ff3b42931f1929ea5d262be53a8d731f087113b32f381fa67b897703bfe1112fac725f4c2aba597a846fee4b2be0c1267c9a92708d3469a1efb0ee03f68760de5b35b002909da632d74441fc2d1650834c9f63b404b793b2c33d7606dc12958e2beb4de0b3c96fe653a1dbf5f96508a161c23b37be0bdab8f2ceded54b700aab69bf8cf3b8079844e6fb1def6c1d0fa48e86a0cda5e487d5e4d455b9b93b760fb9e47fe7342218649b0cb92e5d6bd46edf776b8cc47eb33a5568df291423963754818447e00f02de959f57543d813a17fd5c17bb9010083eb31f826a19f29503d4a0743d3809378e6e7d04572af7362e5ef278019b138e2f577987868ef2ac5f08fc09f5c5e3da4c5ed567e984794c6e92c17bc2d00aa01b4293606a6ced009fdf95b6486f9d4c8330e0bb950836429f667949a460d711904f56d75413861119ac9092162fe3caa33a95d798bc2978f7c092505a0763ff9f1ff7892afdbfd43bc7587180ff4daf585c53918dd0104c40528fd8fd023f111b75310066726f4d7d00723f0315291e471b4bc0c09347141e17a7a0a654fa6593e720815ac289561bff200c2175e95f6191fcb74b22ac8f885c2c5552a990c01857657205ff4199d677a846e5fa16f009eb0be50e88f27da8d51c45ef51ec961ad63118e190c6790025d85c28568dc74d1f44381a001bca01e2a827d3d2d1fd753c807e49e7021d185aadad31867e1281f31f3095e474ea31baaede3646c4c020c06ca24c6896a882d60a64801a1f7af36e1a6308a7c4bda9d41bc6fdaaf86469643bd4e34d7cb635dfafe2cf2e88ed2d11c80bd55e67b4a77bb295f5559a00db01fd4bc0de51efce52ced09d2b6f42c093c3b7396b6632f030a8316144c3a76253ad3a05f0bb9a67d05504e761fc9586d42cb49c6850c0532c4f7339d3d87e43d415daacc456968d864ed923fd797dd4c4e642c28f956fafecc7fb3bef40c0adf63f72b88c1e127f16ab2f1bfdc2b26be12fd13caa08c5ba9de3c5e18ac53a22a2a7972eb6efd97436231ba0185c941dbcc2a288bd2f70769ee7b84b200ccb276edb0d314c80dc08222c33dd1be423aedd419fc0ba822251abc88ed7369fc8301c0a0a83305a3f072c940d0640680c2d84536b47496d4c0941ada28731eccf9174a4d4498f4f810e97c1cd1f874af12641be51984810a5050e72d0a1d45538fa09de641fb7885eb033076f4c37cbd6362de4f6fa5b534fea6721e81f9d883df3e27b1f19444ed5ececbe131d179b1ce9a729317bea696e71bd83b00746c2fc5a36a46cf04deb1ef60ee1d6cf9f8378f246f5cf79f52999938bf05eb3398d30cb70b7d406242889a8bfe86ef11dad89deda4f15165a44063a104e59a968df95e4d5c0fa7b0deab8b13d5804194931bcf7e167f7e8a3218813739844bb2af0afd14a16fdc10b0c2bc0220a3a19f427f7003c08fc5d96f0c1448bc8e2076ad2fc53ecb91680e4af7622296b3442a5b551e2d9b72f0b6a837c9ae303358dacc2c6062974cb544fcf548ced6d37c761bd707cb580a438292783f69837ce430e32cb92c68ce07b96e9c8520b6aff726f608290d508e7de870b39c1a133535264acf2f3d4ff449e4e5ddd0b3e95ef0d6d8187fafdb9dc07ba7eb2e9823e779e5759f1a504ad7c2bb03a04c4bf59c422ed799c110b46bf8b6deba45d84693d456642c0bc558578338530d0eabcbad91359b6482f271fbd4b7a7dc7613334e23e370fb7ce3237af6921a66625e1671ab57c7ef6d8e90ae2d95879869b0f81a6c60bd55ee27f1c1b8c7f27d60c25561e450fe90f992acc1167b788f52ca5f19dd9fb39c723346c4675859d6af8c21f681eb905a26c64dd90ca8c60c7070f798bca3aeff513df1fd16501fc1c8138d73fa97d0cb7751d67a0b4df70070edca9cfaf7f6f727f7e8497300ae337fa50d6afa76a8ce3f9bfafb55df87fd5e356e64dfdf63302fbdf79463418d9fcf4c386fcc4dd967c8d7da6c2fa0be7ba98fe5cafefaeafc563dcf51aa2a358cd17b4ee805f05b625eae18c3c07bb0ace29e75ed7eff58a3eb5d30a0c4f7ab4bd88b98ad3e310bb6e17b96ab8b5f882ba58f4be5fd92992a6dbf2d4854b177ca779989e9153146cc79783b58f07e787347fbc17b870f9e4f0d966bf370c970e8b318c198e4b105eb92cf9ffdb7f5d13d317e6f3bc1f7308fccf2491e42bb3b3da4cec0f72c07cdf461aeacc3ee68bf8fe2bc7e8cb77e21f6ebf3e5df33d794d7229f182d4a1f9962fbcbf9ee83bef4da79fcd19e8f7dfefca02fef3543141f5c63fdfa7cfb417dfed3ba54f6417d7b9fcafbaf9a98bf7b1d5d7d7d55bcb2712f626b96d979d07e4f814257de475afa00ced79ea376ff67ec0f597d78bbc17b747ccfb37be6677ea23f3e3e536065ffe07d5eed736fce1af4e2e61ae5bc411a574c76449261eace32781fee5ebd4ecdbb4c23cfa6ae76aa7a8ed277ac3dd8da10ce37cefb52cebded977bde0bcc2e7b9893984c44b196ce6ea38ea5bcaf9b8e9f0bfe2ebb446f5c819401ee53174920f64eacbc39df6b42b9e93909954bd4a2cb701719fd4de09d6579b674bd5d72f9cef565f833f4dc333f3b75f87bae747d1962ceb7f4fd9b781f62e721dc1fdf99b0fab1bc26c5299b37c8d945fd5896f338dd5b4fb71fff469e83f49d3ac67e237df070fcf9badadec120942f6424c276eba17b3f8950fbf7e3c343aff3d479ec7e9144d8ef3dfc0e1221296e0d89f0a98e43a831b6dfe3c3d3d363bfd76ad57208a54b69456b39849597361cc28643d870081b0e61c3216c38840d87b0e110361cc28643d870081b0e61c3216c38840d87b0e110361cc28643d870081b0e61c3216c3884ff380ea17c4050a2119ae05e9724a816b975d3c81b038d4a82100e8e70ac01f0e8451b8ec286bbd0ebe58cea02549895696b6bf3f96176e9ef56bebd8f3d0b14698fb376fc06ff5d1befbd5907e0cd935d743d24f6e82979c9fa4b6be48e1c777122341cf67c3c3aca420f688cee8550f12c3c2e9ce8bd65e04f76a14f8e62d684e683473f6b461d74e0086890dd4b27a4475add58b7a6ba33c9a32d3c17cad0ef46de19ddf056de934c31e4f04071f409f06d7accdca2f510cf4c42a0231288e713d46396a7c7481ceffe4b1cb7d932a45c8690bfa18391bf480267a8ade0e80ae19ac373e0cfafa1ff3ca547afdaca708f485134c2d36c33d45f1d74404a63ef5d539f832ac2073832857e8cfd4112f80b6ce7153a8c0d36b38b95cc7250f74fa09c8fcc6d25f2c63dee2435a0f537464968e45b4a93d22ce3fd0de8272baf7f02aac0746301445c03183c52ef7699722408c767d666ff97b5217f8b76ee31d8ba176c0fa397af3be8bc288e1b2f5d56e797d89ce481d702fa1c1c2bbeadcde7246ea7707cfc2336936405d43ea37f0a89dbe036da489436637c6270f759b2e7d07976f4b7ec00156b7c14c7d429de53c40d81c4c231e54c1f5e2c237f03c84ae0d9f97a038e595d7a649d7068352a068bf828f8f3991387de3dcd18bdf5922521753c6430054ea720fd41d47a0995821d1d93fb7cd3246a61fc68994afc667894ad0f32aa920cd0fed3129c2a0046c0216736c008a19c074b869bc1dfcd712bead8b9a5dba79ba35170d074f81136fb2fa31e413f61bb4a10db7dd081f20c793bccf42152e6a6c62299ee40d9ba37627961068e72a09eae0ffe021570c85f813fd1f8fdf4db76b3f4613bf0ad24a6d464bbdd47583eef5b018528c28da05f86e2b93c2f4ef5b02dca903d52d7bf6a488938e6cfd73b8bc33024e8056f9770dbdd4f760185120d09ccdc2c5301f6f4dfd03f8b0fe8ce947264b29c24c6ffd4986baffaf0078f5d026525d034d34a56de13409d52dad6d81fb24bd64c67397a29e21bfa01e1214037b20e965e400e3d2d3b36a8ca8bb6e31427a58f26d429027ff3b2753b36cb9b9bf432e54e01732df0e63fe5b230973c4a13db4a0e8c3056910e3badee2385a6086561b425ddb7df569733968f40ef1727d7805cd9dd73f73bf29c3ed051a34b6ffca20595d4477e4ff19b3bcbc095f7352c830965eaee09b59c5133898277e51860ead91d3b0dda07956a49be3b58e68228a33bbde7a83d4f237068634e37748c624e18b5d2d898ef43ff798f6e36fa39a1f7100e39a27ed0f62c3ef6a17e4e26bb05a89943fc2bd06fea5673537fa6048e4aed30b783830bed7bef5aa0230c7bfeec651c4b0afe3f021f601c982fc6d156c41d5530bffb7ed0677f2e8adb71e442de4de701b82218e04e07ff4e0e55b1397508ac4838c182b27b8ae369ea0cfa42e95e8a19a0cad17bb16ba88239e425ea74348c99ebd09453242b14bbbf69eafd14a78c3bfa797c78ff9e6bbc6e5e3b7bf059ec2d24557113e03ccbb27b8fe8d7ed1820998c72cada81f645862e49cfe8b23060cf3dfb08d1236b07e128c5bec7dcfb63d53a9c7979048d90538d550af147fd9746cf2f454c733adc17ea5ddc2a8497e156fff7772a861f2fc5ebe10ec48f741d03fc745b9a500def3c7c00f869b7fe8fd6fdb7f6d0693d3c757a4f5f04fc3cf62b013f6dadfb25c00f29eed754c3b5a78707a61ade693f3d690fad4e8d6ab8f6f42054c36945ab013f355736789f06efd3e07d1abc4f83f769f03e0ddea7c1fb34789f06efd3e07d1abc4f83f769f03e0ddea7c1fb34789f06efd3e07d1abc4f83f769f03eff38bc8f743c20e03e7f3b8467d48223f2830cd999e576badec6798c7017ae964b8f51ec331ce5065e9cbf481004bbdd5f94be3f56417cf8bf059402213a5429c78c340af531e7df43cfcda0aea8922e438024863d53be00d88703ca7c9d89b6d67a79e8d979c81511e8079e4314b536f23df8b1d0262b1d1bc191fdb14625501ccb33863ff4153fc605888d4954b8198444c051984a31aa7163d9bc51eb99fe9d1ea5e3ff23b4078e89f16f2e9ad7b3bff5fff40653a6182129260e63138ed8272d503ab64a6d50531684e104701f038ce02745b07335f63cb84700f541158a2367cd8b3614f02a5eff92fa5a152b1f55493a87924a908d30964a4515bfd5af81e3d0b2f33e5906de3a996d4a6d24f52bfb8ea9cf1385b97111ede644bd406eb731a80d2c12763c6c1958678460b868ae2fb70f8d1fb355a1c291a66bad0f0ab8047ee4f5d4e78c4a7f1b1fe397ce308f28cc2392e018b40c6fa06ccbd5f1c6d05f6a9d89ca810d7546488a50a2c0f1361106dff00c386a1d17724c93fe1d641cf645217000b151e288c262600cdcc4d89229f7a76f91615f25c5feb790aa85c45eaf58fb2e83c8e177abb6dbb30cae5c925946ff44217b5cd581969128bd9b6575c916553cbe1d6fb7f943188a437b425f8362225111644a4243aaf6935f9db6db638a5a9f8c2fd52dc06790439a63c72447d42abdf84adc320812bd16f2f7a4b5f2de33aa020b0ab5a0c89ce2d837449d2ca3d4bf9fb4eb6c331cc5fef0b0f2e6696c30b379b8e7f814d25887be96d584416138f4118e092e09443164eb62ce843c1ef8cf89234cd5610c40bfa4a090ce14fa67fa505b194baa5e4a6080c1ce2d227301f7da05fe248b40c19d40093f57cd55db87a8e6eeeae2e4cca04d85ac7008903b8467e8e18d320c69f7f4518285b503dfc2fbac2fc3baf1c51d20943e1739fc8e7129c70a19cb6bed98bfba54418597e7e63a8728114ff270949f96edf95b39fed887abcf339519459553c4964b63630a2afd248e5e205f0400eb44286a7aa56a3d91ef1e63afd52a78fddcb916fac332cc91a9da2f0036832ab43e9fbb0f65b86259fd877d0026e35f4a2a40a4ef451ba87110d1388fbf5fb82abe724ff6a9507887fb8112fe277d7ed8405e83b1022e18d64e1d77d6862b191e2c7d728a2e90a70600ebdbc233436f71e24a9eceeddfd4bc43a032d6ae5ae14fc42cfb30e827ff77697d34484a39aa5a198b28dddd8c1550952d294eb36b18441ca19d102baf9df23d1126455586982300ac3d59cc0c454cd1f906e3519fe033449b59fc3bd156d6ff246e67fa00fb8d3a36dc2aa199c9e9663e716fd5fe679bb4b61dcbaa63ff81d258116e8608b96365fe63436070fc7e122416c71abaa14cae3c6e4cf18cb26238b84de81b3906bf323f97cb0e90bdf129d40727c79fcf423f7742a62e854a572ecc072f41bb7fa66b17fe7b0a4fe78e2715f339ad63ef2ad69b0b65ee0bf5e1e6552862f1350e1ff7956b984aa5b4cab59798af24c71bbf3ca76915ee0803541b96db01eac6dc9e704d0dede19c9360db7f8bf4dbb8ac5456bb33a7caf594e1d3d23da795b9a82a4e6fbe2bede592e2f1b54dd7e1c97eca952933b78bfb4b032933dfa276eb1c196e17ebee632c013d21586a8b64416830f92b5da38514b21df876016b97b2a29888d54f9fc1cb3b41771bf78aeb0ba355bc1a6e469c1f9ec9fe65f1857de4bdfbc09b75b256dedba4d26f4b7ba9216957be8f12fb1ba2426e9fa84b162dbb52a64a957356afffcdde90d687b64f29f729f593d53c51c957ca8ff4dfea5eda847d2379de9f7e2b51eeaf3c2fdfdeb49b3e4824d5f3c8f7cb7dd642057ada5f3fd89e51d98fb23162ce73baf69395e04fd1d6bd7846eb2062633889b6bd7c0d54a7725d0c71fd27cf0077bb3f947da9d44eea7e76015417f5da91c8a9a4dfd43d71e5b3a5fdaddc5f7c6c89fa55b84528638ea990dfe693ddf111c6ba5034c4ba02c5e410f8937cad91fbf0f71f7abd6b047510107bf94d0544b9da19afb6ada05ce47d9eda5e5279404d328d36828e6519bdb7985042b00e74dec2fdd7ba3ddf875e2b9de940d79be76bd366f31ace6591da8fcc39afb67c4c3912d60bba9bce5fe85c4621ff3817ea498e390b5cf22c8c79fc1b7e6719f85eecc1dabc03d5ec10804bc56692e8495e19b3388f88df14780dd29126c92d3c7cff291c9ca0885fab61c465e4b0f825ff3b010457c379058497bcdda6085e0aeb5621bc3262975c5d7a0f4e60a3e235f93fffcdf9fffbff000000ffff03000b931d70189c0100`)))
@@ -0,0 +1,99 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/iancoleman/strcase"
+)
+
+// Naming styles for json and yaml struct tags
+const (
+	TagNamingCamel    = "camel"
+	TagNamingSnake    = "snake"
+	TagNamingOriginal = "original"
+)
+
+// Validate reports invalid combination of options
+func (opts Options) Validate() error {
+	switch opts.TagNaming {
+	case "", TagNamingCamel, TagNamingSnake, TagNamingOriginal:
+	default:
+		return fmt.Errorf("Unknown tag naming style '%s', expected one of: %s, %s, %s",
+			opts.TagNaming, TagNamingCamel, TagNamingSnake, TagNamingOriginal)
+	}
+	switch opts.MixedContent {
+	case "", MixedContentInnerXml, MixedContentNodes:
+	default:
+		return fmt.Errorf("Unknown mixed content representation '%s', expected one of: %s, %s",
+			opts.MixedContent, MixedContentInnerXml, MixedContentNodes)
+	}
+	switch opts.Order {
+	case "", xsd.TypeOrderDeclaration, xsd.TypeOrderName:
+	default:
+		return fmt.Errorf("Unknown order '%s', expected one of: %s, %s",
+			opts.Order, xsd.TypeOrderDeclaration, xsd.TypeOrderName)
+	}
+	switch opts.Layout {
+	case "", LayoutSingle, LayoutComponent, LayoutSource, LayoutKind:
+	default:
+		return fmt.Errorf("Unknown layout '%s', expected one of: %s, %s, %s, %s",
+			opts.Layout, LayoutSingle, LayoutComponent, LayoutSource, LayoutKind)
+	}
+	return nil
+}
+
+func (opts Options) tagName(xmlName string) string {
+	switch opts.TagNaming {
+	case TagNamingSnake:
+		return strcase.ToSnake(xmlName)
+	case TagNamingOriginal:
+		return xmlName
+	default:
+		return strcase.ToLowerCamel(xmlName)
+	}
+}
+
+// structTag renders complete struct tag, xml tag comes first, then optional json & yaml tags
+func (opts Options) structTag(xmlTag, name string, omitEmpty bool) string {
+	tags := []string{fmt.Sprintf(`xml:"%s"`, xmlTag)}
+	if name != "-" {
+		name = opts.tagName(name)
+		if omitEmpty {
+			name += ",omitempty"
+		}
+	}
+	if opts.JSONTags {
+		tags = append(tags, fmt.Sprintf(`json:"%s"`, name))
+	}
+	if opts.YAMLTags {
+		tags = append(tags, fmt.Sprintf(`yaml:"%s"`, name))
+	}
+	return strings.Join(tags, " ")
+}
+
+func (opts Options) elementTag(el xsd.Element) string {
+	omitEmpty := el.MinOccurs == "0" || el.GoMemLayout() != ""
+	return opts.structTag(el.XmlName(), el.XmlName(), omitEmpty)
+}
+
+func (opts Options) attributeTag(attr xsd.Attribute) string {
+	return opts.structTag(attr.XmlName()+","+attr.Modifiers(), attr.XmlName(), attr.Use != "required")
+}
+
+func (opts Options) xmlNameTag(name string) string {
+	return opts.structTag(name, "-", false)
+}
+
+func (opts Options) textTag() string {
+	return opts.structTag(",chardata", "value", true)
+}
+
+func (opts Options) innerXmlTag() string {
+	return opts.structTag(",innerxml", "innerXml", true)
+}
+
+func (opts Options) openContentTag() string {
+	return opts.structTag(",any", "openContent", true)
+}
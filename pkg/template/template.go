@@ -8,60 +8,251 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"text/template"
 
 	"github.com/gocomply/xsd2go/pkg/xsd"
 	"github.com/markbates/pkger"
 )
 
-func GenerateTypes(schema *xsd.Schema, outputDir string) error {
-	t, err := newTemplate(outputDir)
+// Options controls how go code is generated from the compiled schema
+type Options struct {
+	// TemplateDir is an optional directory with user supplied *.tmpl files. These
+	// override built-in templates of the same name or add new output files.
+	TemplateDir string
+	// JSONTags adds json struct tags next to xml struct tags
+	JSONTags bool
+	// YAMLTags adds yaml struct tags next to xml struct tags
+	YAMLTags bool
+	// TagNaming is the naming style of json & yaml tags: camel (default), snake or original
+	TagNaming string
+	// XMLCodecs generates UnmarshalXML and MarshalXML methods that avoid encoding/xml reflection
+	XMLCodecs bool
+	// MixedContent selects representation of mixed content: innerxml (default) or nodes
+	MixedContent string
+	// Order of generated packages and types: declaration (default) or name
+	Order string
+	// Layout of files the generated structs are split to: single (default), component, source or kind
+	Layout string
+}
+
+// File is a generated file, Path is relative to the output directory
+type File struct {
+	Path    string
+	Content []byte
+}
+
+func GenerateTypes(schema *xsd.Schema, outputDir string, opts Options) error {
+	files, err := RenderTypes(schema, opts)
 	if err != nil {
 		return err
 	}
 
-	packageName := schema.GoPackageName()
-	dir := filepath.Join(outputDir, packageName)
+	dir := filepath.Join(outputDir, schema.GoPackageName())
 	err = os.MkdirAll(dir, os.FileMode(0722))
 	if err != nil {
 		return err
 	}
-	goFile := fmt.Sprintf("%s/models.go", dir)
-	fmt.Printf("\tGenerating '%s'\n", goFile)
-	f, err := os.Create(goFile)
-	if err != nil {
-		return err
+
+	for _, file := range files {
+		goFile := fmt.Sprintf("%s/%s", outputDir, file.Path)
+		schema.Logger().Infof("\tGenerating '%s'", goFile)
+		if err := ioutil.WriteFile(goFile, file.Content, 0644); err != nil {
+			return err
+		}
 	}
 
-	var buf bytes.Buffer
-	if err := t.Execute(&buf, schema); err != nil {
-		return err
+	return nil
+}
+
+// RenderTypes renders go package for given schema in memory, without touching the disk
+func RenderTypes(schema *xsd.Schema, opts Options) ([]File, error) {
+	return RenderPackage([]*xsd.Schema{schema}, opts)
+}
+
+// RenderPackage renders go package shared by given schemas in memory, without touching the
+// disk. Declarations every schema would need, such as codec helpers, are rendered once.
+func RenderPackage(schemas []*xsd.Schema, opts Options) ([]File, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
 	}
+	shared := len(schemas) > 1
+	declared := map[string]bool{}
+	var files []File
+	for _, schema := range schemas {
+		schema.SetTypeOrder(opts.Order)
+		t, err := newTemplate(schema, opts)
+		if err != nil {
+			return nil, err
+		}
 
-	p, err := format.Source(buf.Bytes())
-	if err != nil {
-		return errors.New(err.Error() + " in following file:\n" + string(buf.Bytes()))
+		names := outputTemplates(t)
+		reserved := map[string]bool{}
+		for _, name := range names {
+			reserved[outputFileName(name)] = true
+		}
+		fileNames := map[string]string{}
+		for _, name := range names {
+			if name != "types.tmpl" {
+				fileNames[name] = opts.schemaFileName(schema, name, shared, reserved)
+				reserved[fileNames[name]] = true
+			}
+		}
+
+		for _, name := range names {
+			var data []interface{}
+			if name == "types.tmpl" {
+				for _, f := range opts.packageFiles(schema, reserved) {
+					data = append(data, f)
+				}
+			} else {
+				data = []interface{}{&SchemaFile{Schema: schema, DeclaresHelpers: !declared[name], fileName: fileNames[name]}}
+			}
+			for _, d := range data {
+				file, err := renderFile(t, name, d, opts)
+				if err != nil {
+					return nil, err
+				}
+				if file == nil {
+					// Templates may decide there is nothing to generate for given schema
+					schema.Logger().Verbosef("\tNothing to generate from %s for %s", name, schema.GoPackageName())
+					continue
+				}
+				if _, ok := d.(*SchemaFile); ok {
+					declared[name] = true
+				}
+				file.Path = schema.GoPackageName() + "/" + file.Path
+				files = append(files, *file)
+			}
+		}
 	}
+	return files, nil
+}
 
-	_, err = f.Write(p)
-	if err != nil {
-		return err
+// renderFile executes the template, returns nil when it renders to whitespace only
+func renderFile(t *template.Template, name string, data interface{}, opts Options) (*File, error) {
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(buf.Bytes())) == 0 {
+		return nil, nil
 	}
 
-	return nil
+	fileName := outputFileName(name)
+	prune := false
+	switch f := data.(type) {
+	case *PackageFile:
+		fileName = f.fileName
+		prune = opts.Layout != "" && opts.Layout != LayoutSingle
+	case *SchemaFile:
+		fileName = f.fileName
+		// Files without the shared declarations may not need all imports
+		prune = !f.DeclaresHelpers
+	}
+	p := buf.Bytes()
+	if strings.HasSuffix(fileName, ".go") {
+		var err error
+		p, err = format.Source(buf.Bytes())
+		if err != nil {
+			return nil, errors.New(err.Error() + " in following file:\n" + string(buf.Bytes()))
+		}
+		if prune {
+			if p, err = pruneImports(p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &File{Path: fileName, Content: p}, nil
+}
+
+// builtinTemplates are embedded in the binary, the first one is the root template
+var builtinTemplates = []string{
+	pkger.Include("/pkg/template/types.tmpl"),
+	pkger.Include("/pkg/template/assertions.tmpl"),
+	pkger.Include("/pkg/template/identity.tmpl"),
+	pkger.Include("/pkg/template/ids.tmpl"),
+	pkger.Include("/pkg/template/stream.tmpl"),
+	pkger.Include("/pkg/template/codec.tmpl"),
+	pkger.Include("/pkg/template/_mixed.tmpl"),
 }
 
-func newTemplate(outputDir string) (*template.Template, error) {
-	in, err := pkger.Open("/pkg/template/types.tmpl")
+func newTemplate(schema *xsd.Schema, opts Options) (*template.Template, error) {
+	var t *template.Template
+	for _, path := range builtinTemplates {
+		tempText, err := readBuiltin(path)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			t = template.New(filepath.Base(path)).Funcs(funcMap(schema, opts))
+		} else {
+			t = t.New(filepath.Base(path))
+		}
+		if _, err := t.Parse(tempText); err != nil {
+			return nil, err
+		}
+	}
+	t = t.Lookup("types.tmpl")
+
+	if opts.TemplateDir == "" {
+		return t, nil
+	}
+	files, err := filepath.Glob(filepath.Join(opts.TemplateDir, "*.tmpl"))
 	if err != nil {
 		return nil, err
 	}
-	defer in.Close()
+	if len(files) == 0 {
+		return nil, fmt.Errorf("No *.tmpl files found in template directory '%s'", opts.TemplateDir)
+	}
+	for _, file := range files {
+		text, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		// Parsing template of the same name replaces the built-in one
+		if _, err := t.New(filepath.Base(file)).Parse(string(text)); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
 
-	tempText, err := ioutil.ReadAll(in)
+func readBuiltin(path string) (string, error) {
+	in, err := pkger.Open(path)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	defer in.Close()
+
+	text, err := ioutil.ReadAll(in)
+	return string(text), err
+}
 
-	return template.New("types.tmpl").Funcs(template.FuncMap{}).Parse(string(tempText))
+// outputTemplates lists templates that produce a file. Templates created by {{define}}
+// and file templates prefixed with underscore are partials.
+func outputTemplates(t *template.Template) []string {
+	var names []string
+	for _, tmpl := range t.Templates() {
+		name := tmpl.Name()
+		if strings.HasSuffix(name, ".tmpl") && !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// outputFileName maps template file name to generated file name: types.tmpl produces
+// models.go for historical reasons, foo.tmpl produces foo.go and foo.txt.tmpl produces foo.txt.
+func outputFileName(templateName string) string {
+	if templateName == "types.tmpl" {
+		return "models.go"
+	}
+	name := strings.TrimSuffix(templateName, ".tmpl")
+	if filepath.Ext(name) == "" {
+		name += ".go"
+	}
+	return name
 }
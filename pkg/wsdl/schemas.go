@@ -0,0 +1,128 @@
+package wsdl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// embeddedSchemas cuts xsd:schema elements out of wsdl:types. Schemas commonly rely on
+// namespace prefixes declared by wsdl:definitions, declarations in scope are therefore
+// copied to the schema element. Each schema is named after the WSDL, so that relative
+// imports resolve against its directory.
+func embeddedSchemas(wsdlPath string, data []byte) ([]xsd.Source, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	type scope struct {
+		local string
+		xmlns map[string]string
+	}
+	var stack []scope
+	var res []xsd.Source
+	for {
+		offset := d.InputOffset()
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			declared := map[string]string{}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" {
+					declared[attr.Name.Local] = attr.Value
+				} else if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					declared[""] = attr.Value
+				}
+			}
+			if t.Name.Local != "schema" || len(stack) == 0 || stack[len(stack)-1].local != "types" {
+				stack = append(stack, scope{t.Name.Local, declared})
+				continue
+			}
+			if err := skipRaw(d); err != nil {
+				return nil, err
+			}
+			inherited := map[string]string{}
+			for _, s := range stack {
+				for prefix, uri := range s.xmlns {
+					inherited[prefix] = uri
+				}
+			}
+			for prefix := range declared {
+				delete(inherited, prefix)
+			}
+			res = append(res, xsd.Source{
+				Path: schemaPath(wsdlPath, len(res)+1),
+				Data: withXmlns(data[offset:d.InputOffset()], t.Name, inherited),
+			})
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// schemaPath names n-th schema embedded in the WSDL
+func schemaPath(wsdlPath string, n int) string {
+	ext := path.Ext(wsdlPath)
+	return fmt.Sprintf("%s_types%d.xsd", strings.TrimSuffix(wsdlPath, ext), n)
+}
+
+// withXmlns adds namespace declarations to the start tag of the element
+func withXmlns(element []byte, name xml.Name, xmlns map[string]string) []byte {
+	tag := "<" + name.Local
+	if name.Space != "" {
+		tag = "<" + name.Space + ":" + name.Local
+	}
+	var decls bytes.Buffer
+	for _, prefix := range sortedPrefixes(xmlns) {
+		attr := "xmlns"
+		if prefix != "" {
+			attr += ":" + prefix
+		}
+		fmt.Fprintf(&decls, " %s=\"%s\"", attr, escapeAttr(xmlns[prefix]))
+	}
+	res := append([]byte{}, tag...)
+	res = append(res, decls.Bytes()...)
+	return append(res, element[len(tag):]...)
+}
+
+func escapeAttr(value string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
+
+func sortedPrefixes(xmlns map[string]string) []string {
+	var prefixes []string
+	for prefix := range xmlns {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// skipRaw consumes tokens up to the end of the element just started. Decoder.Skip cannot be
+// used, it checks nesting of elements the raw tokens did not record.
+func skipRaw(d *xml.Decoder) error {
+	for depth := 1; depth > 0; {
+		tok, err := d.RawToken()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package wsdl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/iancoleman/strcase"
+)
+
+// SOAP versions of port bindings
+const (
+	SOAP11 = "1.1"
+	SOAP12 = "1.2"
+)
+
+// Service is wsdl:service client stubs are rendered for, one go package per service
+type Service struct {
+	Name          string
+	GoPackageName string
+	Documentation string
+	// Imports are go packages of schemas declaring the message elements and types
+	Imports []string
+	Ports   []Port
+	// RPCMessages are wrapper structs of rpc style operations
+	RPCMessages []*Message
+}
+
+// Port is wsdl:port with SOAP binding
+type Port struct {
+	Name        string
+	GoName      string
+	Address     string
+	SOAPVersion string
+	Operations  []Operation
+}
+
+// Operation is request-response or one-way operation of the port
+type Operation struct {
+	Name          string
+	GoName        string
+	Documentation string
+	SOAPAction    string
+	RPC           bool
+	Input         *Message
+	// Output is nil for one-way operations
+	Output *Message
+}
+
+// Message is content of soap:Body: the message element for document style, generated
+// wrapper of the parts for rpc style
+type Message struct {
+	// Name of the body element, the rpc wrapper is named after the operation
+	Name xml.Name
+	// GoType is struct the body element is encoded from, qualified by its go package
+	GoType string
+	Parts  []Part
+}
+
+// Part is field of rpc wrapper struct
+type Part struct {
+	Name   string
+	GoName string
+	GoType string
+}
+
+var nonPackageChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Services describes SOAP services of the document. Message elements and types are looked
+// up in the workspace the embedded schemas were loaded to. Ports without SOAP binding are
+// skipped.
+func (doc *Document) Services(ws *xsd.Workspace) ([]Service, error) {
+	d := &doc.definitions
+	var res []Service
+	for _, svc := range d.Services {
+		s := Service{
+			Name:          svc.Name,
+			GoPackageName: nonPackageChars.ReplaceAllString(strings.ToLower(svc.Name), ""),
+			Documentation: strings.TrimSpace(svc.Documentation),
+		}
+		b := stubBuilder{doc: doc, ws: ws, service: &s, imports: map[string]bool{}, rpc: map[string]bool{}}
+		for _, p := range svc.Ports {
+			port, err := b.port(p)
+			if err != nil {
+				return nil, err
+			}
+			if port != nil {
+				s.Ports = append(s.Ports, *port)
+			}
+		}
+		for imp := range b.imports {
+			s.Imports = append(s.Imports, imp)
+		}
+		sort.Strings(s.Imports)
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+type stubBuilder struct {
+	doc     *Document
+	ws      *xsd.Workspace
+	service *Service
+	imports map[string]bool
+	// rpc tracks go names of rpc wrappers generated already
+	rpc map[string]bool
+}
+
+func (b *stubBuilder) port(p port) (*Port, error) {
+	d := &b.doc.definitions
+	bind := d.binding(p.Binding)
+	if bind == nil {
+		return nil, fmt.Errorf("Port %s of WSDL %s refers to unknown binding %s", p.Name, b.doc.path, p.Binding)
+	}
+	res := &Port{Name: p.Name, GoName: strcase.ToCamel(p.Name)}
+	var style string
+	switch {
+	case bind.SOAP11 != nil && p.SOAP11 != nil:
+		res.SOAPVersion, res.Address, style = SOAP11, p.SOAP11.Location, bind.SOAP11.Style
+	case bind.SOAP12 != nil && p.SOAP12 != nil:
+		res.SOAPVersion, res.Address, style = SOAP12, p.SOAP12.Location, bind.SOAP12.Style
+	default:
+		return nil, nil
+	}
+	pt := d.portType(bind.Type)
+	if pt == nil {
+		return nil, fmt.Errorf("Binding %s of WSDL %s refers to unknown portType %s", bind.Name, b.doc.path, bind.Type)
+	}
+	for _, bop := range bind.Operations {
+		op, err := b.operation(pt, bind, bop, style)
+		if err != nil {
+			return nil, err
+		}
+		if op != nil {
+			res.Operations = append(res.Operations, *op)
+		}
+	}
+	return res, nil
+}
+
+func (b *stubBuilder) operation(pt *portType, bind *binding, bop bindingOperation, style string) (*Operation, error) {
+	var abstract *operation
+	for idx := range pt.Operations {
+		if pt.Operations[idx].Name == bop.Name {
+			abstract = &pt.Operations[idx]
+		}
+	}
+	if abstract == nil {
+		return nil, fmt.Errorf("Operation %s of binding %s is not declared by portType %s", bop.Name, bind.Name, pt.Name)
+	}
+	if abstract.Input == nil {
+		// Notification and solicit-response operations are initiated by the service
+		return nil, nil
+	}
+	res := &Operation{
+		Name:          bop.Name,
+		GoName:        strcase.ToCamel(bop.Name),
+		Documentation: strings.TrimSpace(abstract.Documentation),
+	}
+	soapOp := bop.SOAP11
+	if bind.SOAP12 != nil {
+		soapOp = bop.SOAP12
+	}
+	if soapOp != nil {
+		res.SOAPAction = soapOp.SOAPAction
+		if soapOp.Style != "" {
+			style = soapOp.Style
+		}
+	}
+	res.RPC = style == "rpc"
+
+	var err error
+	if res.Input, err = b.message(res, abstract.Input, bop.Input, ""); err != nil {
+		return nil, err
+	}
+	if abstract.Output != nil {
+		if res.Output, err = b.message(res, abstract.Output, bop.Output, "Response"); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (b *stubBuilder) message(op *Operation, ref *operationMsg, bm *bindingMsg, suffix string) (*Message, error) {
+	d := &b.doc.definitions
+	msg := d.message(ref.Message)
+	if msg == nil {
+		return nil, fmt.Errorf("Operation %s of WSDL %s refers to unknown message %s", op.Name, b.doc.path, ref.Message)
+	}
+	body := &soapBody{Use: "literal"}
+	if bm != nil && bm.SOAP11 != nil {
+		body = bm.SOAP11
+	} else if bm != nil && bm.SOAP12 != nil {
+		body = bm.SOAP12
+	}
+	if body.Use == "encoded" {
+		return nil, fmt.Errorf("Operation %s of WSDL %s uses SOAP encoding, only literal use is supported", op.Name, b.doc.path)
+	}
+	parts := msg.Parts
+	if body.Parts != "" {
+		parts = nil
+		for _, name := range strings.Fields(body.Parts) {
+			for _, p := range msg.Parts {
+				if p.Name == name {
+					parts = append(parts, p)
+				}
+			}
+		}
+	}
+
+	if !op.RPC {
+		if len(parts) != 1 || parts[0].Element == "" {
+			return nil, fmt.Errorf("Message %s of document style operation %s must have single part referring to an element", msg.Name, op.Name)
+		}
+		name := d.resolve(parts[0].Element)
+		goType, err := b.elementType(name)
+		if err != nil {
+			return nil, err
+		}
+		return &Message{Name: name, GoType: goType}, nil
+	}
+
+	goSuffix := suffix
+	if goSuffix == "" {
+		goSuffix = "Request"
+	}
+	res := &Message{
+		Name:   xml.Name{Space: body.Namespace, Local: op.Name + suffix},
+		GoType: op.GoName + goSuffix,
+	}
+	for _, p := range parts {
+		field := Part{Name: p.Name, GoName: strcase.ToCamel(p.Name)}
+		var err error
+		if p.Element != "" {
+			name := d.resolve(p.Element)
+			field.Name = name.Local
+			field.GoType, err = b.elementType(name)
+		} else {
+			field.GoType, err = b.typeName(d.resolve(p.Type))
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Parts = append(res.Parts, field)
+	}
+	if !b.rpc[res.GoType] {
+		b.rpc[res.GoType] = true
+		b.service.RPCMessages = append(b.service.RPCMessages, res)
+	}
+	return res, nil
+}
+
+// elementType returns qualified go type of global element declared by embedded schema
+func (b *stubBuilder) elementType(name xml.Name) (string, error) {
+	for _, sch := range b.ws.Schemas() {
+		if sch.TargetNamespace != name.Space {
+			continue
+		}
+		if el := sch.GetElement(name.Local); el != nil {
+			return b.qualify(sch, el.GoName()), nil
+		}
+	}
+	return "", fmt.Errorf("Element {%s}%s used by WSDL %s is not declared by any schema", name.Space, name.Local, b.doc.path)
+}
+
+// typeName returns go type of XSD type referred to by rpc style message part
+func (b *stubBuilder) typeName(name xml.Name) (string, error) {
+	if name.Space == "http://www.w3.org/2001/XMLSchema" {
+		if goType, found := xsd.BuiltinGoType(name.Local); found {
+			return goType, nil
+		}
+	}
+	for _, sch := range b.ws.Schemas() {
+		if sch.TargetNamespace != name.Space {
+			continue
+		}
+		switch typ := sch.GetType(name.Local).(type) {
+		case nil:
+			continue
+		case *xsd.ComplexType:
+			return b.qualify(sch, typ.GoTypeName()), nil
+		default:
+			return typ.GoTypeName(), nil
+		}
+	}
+	return "", fmt.Errorf("Type {%s}%s used by WSDL %s is not declared by any schema", name.Space, name.Local, b.doc.path)
+}
+
+func (b *stubBuilder) qualify(sch *xsd.Schema, goName string) string {
+	b.imports[sch.ModulesPath+"/"+sch.GoPackageName()] = true
+	return sch.GoPackageName() + "." + goName
+}
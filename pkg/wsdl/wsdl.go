@@ -0,0 +1,199 @@
+// Package wsdl reads WSDL 1.1 documents: schemas embedded in wsdl:types are handed over to
+// xsd.Workspace and SOAP 1.1 and 1.2 bindings of wsdl:service ports are described for
+// rendering of typed client stubs.
+package wsdl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Namespaces of WSDL 1.1 and its SOAP bindings
+const (
+	Namespace       = "http://schemas.xmlsoap.org/wsdl/"
+	SOAP11Namespace = "http://schemas.xmlsoap.org/wsdl/soap/"
+	SOAP12Namespace = "http://schemas.xmlsoap.org/wsdl/soap12/"
+)
+
+// Document is parsed WSDL 1.1 document
+type Document struct {
+	path        string
+	definitions definitions
+	schemas     []xsd.Source
+}
+
+type definitions struct {
+	XMLName         xml.Name   `xml:"http://schemas.xmlsoap.org/wsdl/ definitions"`
+	Name            string     `xml:"name,attr"`
+	TargetNamespace string     `xml:"targetNamespace,attr"`
+	Imports         []wsdlRef  `xml:"http://schemas.xmlsoap.org/wsdl/ import"`
+	Messages        []message  `xml:"http://schemas.xmlsoap.org/wsdl/ message"`
+	PortTypes       []portType `xml:"http://schemas.xmlsoap.org/wsdl/ portType"`
+	Bindings        []binding  `xml:"http://schemas.xmlsoap.org/wsdl/ binding"`
+	Services        []service  `xml:"http://schemas.xmlsoap.org/wsdl/ service"`
+	xmlns           map[string]string
+}
+
+func (d *definitions) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	d.xmlns = map[string]string{}
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" {
+			d.xmlns[attr.Name.Local] = attr.Value
+		} else if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			d.xmlns[""] = attr.Value
+		}
+	}
+	type defs definitions
+	return dec.DecodeElement((*defs)(d), &start)
+}
+
+type wsdlRef struct {
+	Namespace string `xml:"namespace,attr"`
+	Location  string `xml:"location,attr"`
+}
+
+type message struct {
+	Name  string `xml:"name,attr"`
+	Parts []part `xml:"http://schemas.xmlsoap.org/wsdl/ part"`
+}
+
+type part struct {
+	Name    string `xml:"name,attr"`
+	Element string `xml:"element,attr"`
+	Type    string `xml:"type,attr"`
+}
+
+type portType struct {
+	Name       string      `xml:"name,attr"`
+	Operations []operation `xml:"http://schemas.xmlsoap.org/wsdl/ operation"`
+}
+
+type operation struct {
+	Name          string        `xml:"name,attr"`
+	Documentation string        `xml:"http://schemas.xmlsoap.org/wsdl/ documentation"`
+	Input         *operationMsg `xml:"http://schemas.xmlsoap.org/wsdl/ input"`
+	Output        *operationMsg `xml:"http://schemas.xmlsoap.org/wsdl/ output"`
+}
+
+type operationMsg struct {
+	Message string `xml:"message,attr"`
+}
+
+type binding struct {
+	Name       string             `xml:"name,attr"`
+	Type       string             `xml:"type,attr"`
+	SOAP11     *soapBinding       `xml:"http://schemas.xmlsoap.org/wsdl/soap/ binding"`
+	SOAP12     *soapBinding       `xml:"http://schemas.xmlsoap.org/wsdl/soap12/ binding"`
+	Operations []bindingOperation `xml:"http://schemas.xmlsoap.org/wsdl/ operation"`
+}
+
+type soapBinding struct {
+	Style     string `xml:"style,attr"`
+	Transport string `xml:"transport,attr"`
+}
+
+type bindingOperation struct {
+	Name   string         `xml:"name,attr"`
+	SOAP11 *soapOperation `xml:"http://schemas.xmlsoap.org/wsdl/soap/ operation"`
+	SOAP12 *soapOperation `xml:"http://schemas.xmlsoap.org/wsdl/soap12/ operation"`
+	Input  *bindingMsg    `xml:"http://schemas.xmlsoap.org/wsdl/ input"`
+	Output *bindingMsg    `xml:"http://schemas.xmlsoap.org/wsdl/ output"`
+}
+
+type soapOperation struct {
+	SOAPAction string `xml:"soapAction,attr"`
+	Style      string `xml:"style,attr"`
+}
+
+type bindingMsg struct {
+	SOAP11 *soapBody `xml:"http://schemas.xmlsoap.org/wsdl/soap/ body"`
+	SOAP12 *soapBody `xml:"http://schemas.xmlsoap.org/wsdl/soap12/ body"`
+}
+
+type soapBody struct {
+	Use       string `xml:"use,attr"`
+	Namespace string `xml:"namespace,attr"`
+	Parts     string `xml:"parts,attr"`
+}
+
+type service struct {
+	Name          string `xml:"name,attr"`
+	Documentation string `xml:"http://schemas.xmlsoap.org/wsdl/ documentation"`
+	Ports         []port `xml:"http://schemas.xmlsoap.org/wsdl/ port"`
+}
+
+type port struct {
+	Name    string       `xml:"name,attr"`
+	Binding string       `xml:"binding,attr"`
+	SOAP11  *soapAddress `xml:"http://schemas.xmlsoap.org/wsdl/soap/ address"`
+	SOAP12  *soapAddress `xml:"http://schemas.xmlsoap.org/wsdl/soap12/ address"`
+}
+
+type soapAddress struct {
+	Location string `xml:"location,attr"`
+}
+
+// Parse reads WSDL document, path names the document in messages and relative imports of
+// the embedded schemas are resolved against it
+func Parse(path string, data []byte) (*Document, error) {
+	doc := &Document{path: path}
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc.definitions); err != nil {
+		return nil, fmt.Errorf("Error decoding WSDL %s: %s", path, err)
+	}
+	if len(doc.definitions.Imports) > 0 {
+		return nil, fmt.Errorf("WSDL %s uses wsdl:import of %s, which is not supported", path, doc.definitions.Imports[0].Location)
+	}
+	var err error
+	if doc.schemas, err = embeddedSchemas(path, data); err != nil {
+		return nil, fmt.Errorf("Error decoding WSDL %s: %s", path, err)
+	}
+	return doc, nil
+}
+
+// Schemas lists schemas embedded in wsdl:types, see xsd.Workspace.LoadSources
+func (doc *Document) Schemas() []xsd.Source {
+	return append([]xsd.Source{}, doc.schemas...)
+}
+
+// resolve splits qualified name such as "tns:GetQuote" to namespace and local name using
+// namespace declarations of wsdl:definitions
+func (d *definitions) resolve(qname string) xml.Name {
+	if idx := strings.Index(qname, ":"); idx != -1 {
+		return xml.Name{Space: d.xmlns[qname[:idx]], Local: qname[idx+1:]}
+	}
+	return xml.Name{Space: d.xmlns[""], Local: qname}
+}
+
+func (d *definitions) message(qname string) *message {
+	name := d.resolve(qname)
+	for idx := range d.Messages {
+		if d.Messages[idx].Name == name.Local {
+			return &d.Messages[idx]
+		}
+	}
+	return nil
+}
+
+func (d *definitions) portType(qname string) *portType {
+	name := d.resolve(qname)
+	for idx := range d.PortTypes {
+		if d.PortTypes[idx].Name == name.Local {
+			return &d.PortTypes[idx]
+		}
+	}
+	return nil
+}
+
+func (d *definitions) binding(qname string) *binding {
+	name := d.resolve(qname)
+	for idx := range d.Bindings {
+		if d.Bindings[idx].Name == name.Local {
+			return &d.Bindings[idx]
+		}
+	}
+	return nil
+}
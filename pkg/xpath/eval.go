@@ -0,0 +1,717 @@
+package xpath
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// item is *Node, attribute, untyped (value of a node), string, float64 or bool
+type item interface{}
+
+// untyped is atomized value of a node, compared as number when compared with number or when
+// both compared values are numeric
+type untyped string
+
+// Context the expression is evaluated in
+type Context struct {
+	// Node is the context item, the element assertion or alternative applies to
+	Node *Node
+	// Value is bound to $value: value of simple content or of the simple type being asserted
+	Value *string
+	// Namespaces maps prefixes of element and attribute names to namespaces. Unprefixed
+	// element names match XPathDefaultNamespace.
+	Namespaces            map[string]string
+	XPathDefaultNamespace string
+	// IgnoreNamespaces matches element and attribute names by their local part only
+	IgnoreNamespaces bool
+}
+
+type evaluation struct {
+	*Context
+	focus    item
+	position int
+	size     int
+}
+
+// Bool evaluates effective boolean value of the expression
+func (e *Expr) Bool(ctx *Context) (bool, error) {
+	ev := newEvaluation(ctx)
+	seq, err := ev.eval(e.root)
+	if err == nil {
+		var res bool
+		if res, err = effectiveBool(toInterfaces(seq)); err == nil {
+			return res, nil
+		}
+	}
+	return false, fmt.Errorf("Cannot evaluate XPath '%s': %s", e.source, err)
+}
+
+// Evaluate returns sequence the expression evaluates to. Items of the sequence are *Node,
+// string, float64 or bool, attributes are returned as strings.
+func (e *Expr) Evaluate(ctx *Context) ([]interface{}, error) {
+	ev := newEvaluation(ctx)
+	seq, err := ev.eval(e.root)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot evaluate XPath '%s': %s", e.source, err)
+	}
+	res := make([]interface{}, len(seq))
+	for idx, it := range seq {
+		switch v := it.(type) {
+		case attribute:
+			res[idx] = v.value
+		case untyped:
+			res[idx] = string(v)
+		default:
+			res[idx] = v
+		}
+	}
+	return res, nil
+}
+
+func newEvaluation(ctx *Context) *evaluation {
+	ev := &evaluation{Context: ctx, position: 1, size: 1}
+	if ctx.Node != nil {
+		ev.focus = ctx.Node
+	}
+	return ev
+}
+
+func (ev *evaluation) eval(e expr) ([]item, error) {
+	switch e := e.(type) {
+	case literal:
+		return []item{e.value}, nil
+	case variable:
+		if e.name != "value" || ev.Value == nil {
+			return nil, fmt.Errorf("unknown variable $%s", e.name)
+		}
+		return []item{untyped(*ev.Value)}, nil
+	case contextItem:
+		if ev.focus == nil {
+			return nil, fmt.Errorf("context item is undefined")
+		}
+		return []item{ev.focus}, nil
+	case sequence:
+		var res []item
+		for _, it := range e.items {
+			seq, err := ev.eval(it)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, seq...)
+		}
+		return res, nil
+	case negation:
+		seq, err := ev.eval(e.operand)
+		if err != nil || len(seq) == 0 {
+			return nil, err
+		}
+		n, err := singleNumber(seq)
+		return []item{-n}, err
+	case binary:
+		return ev.evalBinary(e)
+	case call:
+		return ev.evalCall(e)
+	case path:
+		return ev.evalPath(e)
+	}
+	return nil, fmt.Errorf("unknown expression %T", e)
+}
+
+func (ev *evaluation) evalPath(p path) ([]item, error) {
+	current := []item{ev.focus}
+	if p.start != nil {
+		var err error
+		if current, err = ev.eval(p.start); err != nil {
+			return nil, err
+		}
+	} else if ev.focus == nil {
+		return nil, fmt.Errorf("context item is undefined")
+	}
+	for _, s := range p.steps {
+		var next []item
+		seen := map[item]bool{}
+		for _, it := range current {
+			selected, err := ev.evalStep(s, it)
+			if err != nil {
+				return nil, err
+			}
+			for _, sel := range selected {
+				switch sel.(type) {
+				case *Node, attribute:
+					if seen[sel] {
+						continue
+					}
+					seen[sel] = true
+				}
+				next = append(next, sel)
+			}
+		}
+		current = next
+	}
+	return current, nil
+}
+
+func (ev *evaluation) evalStep(s step, it item) ([]item, error) {
+	var candidates []item
+	n, isNode := it.(*Node)
+	switch s.axis {
+	case "self":
+		candidates = []item{it}
+	case "child", "descendant", "descendant-or-self", "parent", "attribute":
+		if !isNode {
+			if s.axis == "parent" {
+				if attr, ok := it.(attribute); ok {
+					candidates = []item{attr.parent}
+					break
+				}
+			}
+			return nil, fmt.Errorf("%s axis applied to atomic value", s.axis)
+		}
+		switch s.axis {
+		case "child":
+			for _, child := range n.Children {
+				candidates = append(candidates, child)
+			}
+		case "descendant", "descendant-or-self":
+			if s.axis == "descendant-or-self" {
+				candidates = append(candidates, n)
+			}
+			var walk func(*Node)
+			walk = func(parent *Node) {
+				for _, child := range parent.Children {
+					candidates = append(candidates, child)
+					walk(child)
+				}
+			}
+			walk(n)
+		case "parent":
+			if n.Parent != nil {
+				candidates = []item{n.Parent}
+			}
+		case "attribute":
+			for _, attr := range n.Attrs {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				candidates = append(candidates, attribute{name: attr.Name, value: attr.Value, parent: n})
+			}
+		}
+	}
+
+	var matched []item
+	for _, c := range candidates {
+		ok, err := ev.matches(s, c)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, c)
+		}
+	}
+	for _, predicate := range s.predicates {
+		var filtered []item
+		for idx, c := range matched {
+			inner := &evaluation{Context: ev.Context, focus: c, position: idx + 1, size: len(matched)}
+			res, err := inner.eval(predicate)
+			if err != nil {
+				return nil, err
+			}
+			if len(res) == 1 {
+				if pos, ok := res[0].(float64); ok {
+					if int(pos) == idx+1 {
+						filtered = append(filtered, c)
+					}
+					continue
+				}
+			}
+			ok, err := effectiveBool(toInterfaces(res))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				filtered = append(filtered, c)
+			}
+		}
+		matched = filtered
+	}
+	return matched, nil
+}
+
+func (ev *evaluation) matches(s step, it item) (bool, error) {
+	var name xml.Name
+	switch v := it.(type) {
+	case *Node:
+		if s.axis == "attribute" {
+			return false, nil
+		}
+		name = v.Name
+	case attribute:
+		name = v.name
+	default:
+		return s.axis == "self" && s.local == "*", nil
+	}
+	if s.local == "*" {
+		return true, nil
+	}
+	if name.Local != s.local {
+		return false, nil
+	}
+	if ev.IgnoreNamespaces {
+		return true, nil
+	}
+	ns := ""
+	if s.prefix != "" {
+		var found bool
+		if ns, found = ev.Namespaces[s.prefix]; !found {
+			return false, fmt.Errorf("unknown namespace prefix '%s'", s.prefix)
+		}
+	} else if s.axis != "attribute" {
+		ns = ev.XPathDefaultNamespace
+	}
+	return name.Space == ns, nil
+}
+
+func (ev *evaluation) evalBinary(b binary) ([]item, error) {
+	left, err := ev.eval(b.left)
+	if err != nil {
+		return nil, err
+	}
+	switch b.op {
+	case "and", "or":
+		l, err := effectiveBool(toInterfaces(left))
+		if err != nil {
+			return nil, err
+		}
+		if (b.op == "and" && !l) || (b.op == "or" && l) {
+			return []item{l}, nil
+		}
+		right, err := ev.eval(b.right)
+		if err != nil {
+			return nil, err
+		}
+		r, err := effectiveBool(toInterfaces(right))
+		return []item{r}, err
+	}
+	right, err := ev.eval(b.right)
+	if err != nil {
+		return nil, err
+	}
+	switch b.op {
+	case "|", "union":
+		return append(left, right...), nil
+	case "=", "!=", "<", "<=", ">", ">=":
+		for _, l := range left {
+			for _, r := range right {
+				if compare(b.op, atomize(l), atomize(r)) {
+					return []item{true}, nil
+				}
+			}
+		}
+		return []item{false}, nil
+	case "eq", "ne", "lt", "le", "gt", "ge":
+		if len(left) == 0 || len(right) == 0 {
+			return nil, nil
+		}
+		if len(left) > 1 || len(right) > 1 {
+			return nil, fmt.Errorf("value comparison '%s' applied to sequence of more than one item", b.op)
+		}
+		op := map[string]string{"eq": "=", "ne": "!=", "lt": "<", "le": "<=", "gt": ">", "ge": ">="}[b.op]
+		return []item{compare(op, atomize(left[0]), atomize(right[0]))}, nil
+	}
+	if len(left) == 0 || len(right) == 0 {
+		return nil, nil
+	}
+	l, err := singleNumber(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := singleNumber(right)
+	if err != nil {
+		return nil, err
+	}
+	switch b.op {
+	case "+":
+		return []item{l + r}, nil
+	case "-":
+		return []item{l - r}, nil
+	case "*":
+		return []item{l * r}, nil
+	case "div":
+		return []item{l / r}, nil
+	case "idiv":
+		if r == 0 {
+			return nil, fmt.Errorf("integer division by zero")
+		}
+		return []item{math.Trunc(l / r)}, nil
+	case "mod":
+		return []item{math.Mod(l, r)}, nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", b.op)
+}
+
+// atomize turns nodes to their untyped values
+func atomize(it item) item {
+	switch v := it.(type) {
+	case *Node:
+		return untyped(v.StringValue())
+	case attribute:
+		return untyped(v.value)
+	}
+	return it
+}
+
+func compare(op string, l, r item) bool {
+	_, lNum := l.(float64)
+	_, rNum := r.(float64)
+	lu, lUntyped := l.(untyped)
+	ru, rUntyped := r.(untyped)
+	if lUntyped && rUntyped {
+		// Without schema types at hand, values that both look numeric are compared as numbers
+		_, lerr := strconv.ParseFloat(strings.TrimSpace(string(lu)), 64)
+		_, rerr := strconv.ParseFloat(strings.TrimSpace(string(ru)), 64)
+		lNum, rNum = lerr == nil, rerr == nil
+		lNum, rNum = lNum && rNum, lNum && rNum
+	}
+	_, lBool := l.(bool)
+	_, rBool := r.(bool)
+	var c int
+	switch {
+	case lBool || rBool:
+		lb, _ := effectiveBool([]interface{}{l})
+		rb, _ := effectiveBool([]interface{}{r})
+		if op != "=" && op != "!=" {
+			return false
+		}
+		c = 1
+		if lb == rb {
+			c = 0
+		}
+	case lNum || rNum:
+		ln, lerr := toNumber(l)
+		rn, rerr := toNumber(r)
+		if lerr != nil || rerr != nil || math.IsNaN(ln) || math.IsNaN(rn) {
+			return op == "!="
+		}
+		switch {
+		case ln < rn:
+			c = -1
+		case ln > rn:
+			c = 1
+		}
+	default:
+		c = strings.Compare(toString(l), toString(r))
+	}
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func toInterfaces(seq []item) []interface{} {
+	res := make([]interface{}, len(seq))
+	for idx := range seq {
+		res[idx] = seq[idx]
+	}
+	return res
+}
+
+func effectiveBool(seq []interface{}) (bool, error) {
+	if len(seq) == 0 {
+		return false, nil
+	}
+	switch seq[0].(type) {
+	case *Node, attribute:
+		return true, nil
+	}
+	if len(seq) > 1 {
+		return false, fmt.Errorf("effective boolean value of sequence of more than one atomic value is not defined")
+	}
+	switch v := seq[0].(type) {
+	case bool:
+		return v, nil
+	case string:
+		return v != "", nil
+	case untyped:
+		return v != "", nil
+	case float64:
+		return v != 0 && !math.IsNaN(v), nil
+	}
+	return false, fmt.Errorf("effective boolean value of %v is not defined", seq[0])
+}
+
+func toString(it item) string {
+	switch v := atomize(it).(type) {
+	case string:
+		return v
+	case untyped:
+		return string(v)
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func toNumber(it item) (float64, error) {
+	switch v := atomize(it).(type) {
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case untyped:
+		return strconv.ParseFloat(strings.TrimSpace(string(v)), 64)
+	}
+	return math.NaN(), fmt.Errorf("cannot convert %v to number", it)
+}
+
+func singleNumber(seq []item) (float64, error) {
+	if len(seq) != 1 {
+		return 0, fmt.Errorf("arithmetic applied to sequence of %d items", len(seq))
+	}
+	n, err := toNumber(seq[0])
+	if err != nil {
+		return math.NaN(), nil
+	}
+	return n, nil
+}
+
+func (ev *evaluation) evalCall(c call) ([]item, error) {
+	var args [][]item
+	for _, arg := range c.args {
+		seq, err := ev.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, seq)
+	}
+	arity := func(min, max int) error {
+		if len(args) < min || len(args) > max {
+			return fmt.Errorf("wrong number of arguments of %s()", c.name)
+		}
+		return nil
+	}
+	// Optional single argument defaults to the context item
+	contextArg := func() ([]item, error) {
+		if err := arity(0, 1); err != nil {
+			return nil, err
+		}
+		if len(args) == 1 {
+			return args[0], nil
+		}
+		return ev.eval(contextItem{})
+	}
+	str := func(seq []item) string {
+		if len(seq) == 0 {
+			return ""
+		}
+		return toString(seq[0])
+	}
+
+	if c.prefix != "" && c.prefix != "fn" {
+		return constructor(c.name, args)
+	}
+	switch c.name {
+	case "true", "false":
+		return []item{c.name == "true"}, arity(0, 0)
+	case "not", "boolean":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		b, err := effectiveBool(toInterfaces(args[0]))
+		if c.name == "not" {
+			b = !b
+		}
+		return []item{b}, err
+	case "count":
+		return []item{float64(len(args[0]))}, arity(1, 1)
+	case "exists", "empty":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		return []item{(len(args[0]) > 0) == (c.name == "exists")}, nil
+	case "position":
+		return []item{float64(ev.position)}, arity(0, 0)
+	case "last":
+		return []item{float64(ev.size)}, arity(0, 0)
+	case "data":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		var res []item
+		for _, it := range args[0] {
+			res = append(res, atomize(it))
+		}
+		return res, nil
+	case "string", "normalize-space", "string-length", "upper-case", "lower-case", "local-name", "name":
+		seq, err := contextArg()
+		if err != nil || (len(seq) == 0 && c.name != "string-length") {
+			return []item{""}, err
+		}
+		switch c.name {
+		case "string":
+			return []item{str(seq)}, nil
+		case "normalize-space":
+			return []item{strings.Join(strings.Fields(str(seq)), " ")}, nil
+		case "string-length":
+			return []item{float64(len([]rune(str(seq))))}, nil
+		case "upper-case":
+			return []item{strings.ToUpper(str(seq))}, nil
+		case "lower-case":
+			return []item{strings.ToLower(str(seq))}, nil
+		}
+		var name xml.Name
+		switch v := seq[0].(type) {
+		case *Node:
+			name = v.Name
+		case attribute:
+			name = v.name
+		}
+		return []item{name.Local}, nil
+	case "number":
+		seq, err := contextArg()
+		if err != nil || len(seq) == 0 {
+			return []item{math.NaN()}, err
+		}
+		n, err := toNumber(seq[0])
+		if err != nil {
+			n = math.NaN()
+		}
+		return []item{n}, nil
+	case "contains", "starts-with", "ends-with", "matches":
+		if err := arity(2, 2); err != nil {
+			return nil, err
+		}
+		s, sub := str(args[0]), str(args[1])
+		switch c.name {
+		case "contains":
+			return []item{strings.Contains(s, sub)}, nil
+		case "starts-with":
+			return []item{strings.HasPrefix(s, sub)}, nil
+		case "ends-with":
+			return []item{strings.HasSuffix(s, sub)}, nil
+		}
+		re, err := regexp.Compile(sub)
+		if err != nil {
+			return nil, err
+		}
+		return []item{re.MatchString(s)}, nil
+	case "concat":
+		var sb strings.Builder
+		for _, arg := range args {
+			sb.WriteString(str(arg))
+		}
+		return []item{sb.String()}, nil
+	case "sum", "min", "max", "avg":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		if len(args[0]) == 0 {
+			if c.name == "sum" {
+				return []item{float64(0)}, nil
+			}
+			return nil, nil
+		}
+		var res float64
+		for idx, it := range args[0] {
+			n, err := toNumber(it)
+			if err != nil {
+				return []item{math.NaN()}, nil
+			}
+			switch {
+			case idx == 0 || c.name == "sum" || c.name == "avg":
+				if idx == 0 {
+					res = n
+				} else {
+					res += n
+				}
+			case c.name == "min":
+				res = math.Min(res, n)
+			case c.name == "max":
+				res = math.Max(res, n)
+			}
+		}
+		if c.name == "avg" {
+			res /= float64(len(args[0]))
+		}
+		return []item{res}, nil
+	case "abs", "floor", "ceiling", "round":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		if len(args[0]) == 0 {
+			return nil, nil
+		}
+		n, err := singleNumber(args[0])
+		if err != nil {
+			return nil, err
+		}
+		f := map[string]func(float64) float64{"abs": math.Abs, "floor": math.Floor, "ceiling": math.Ceil,
+			"round": func(x float64) float64 { return math.Floor(x + 0.5) }}[c.name]
+		return []item{f(n)}, nil
+	}
+	return nil, fmt.Errorf("unknown function %s()", c.name)
+}
+
+// constructor implements constructor functions of XSD built-in types such as xs:date(@start).
+// Numeric types convert to numbers, dates and times keep their lexical form, which compares
+// correctly as long as time zones match.
+func constructor(name string, args [][]item) ([]item, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("wrong number of arguments of %s()", name)
+	}
+	if len(args[0]) == 0 {
+		return nil, nil
+	}
+	if len(args[0]) > 1 {
+		return nil, fmt.Errorf("%s() applied to sequence of %d items", name, len(args[0]))
+	}
+	value := strings.TrimSpace(toString(args[0][0]))
+	switch name {
+	case "string", "anyURI", "token", "normalizedString", "NCName", "QName", "ID", "IDREF",
+		"date", "dateTime", "time", "gYear", "gYearMonth", "duration", "dayTimeDuration", "yearMonthDuration":
+		return []item{value}, nil
+	case "boolean":
+		switch value {
+		case "true", "1":
+			return []item{true}, nil
+		case "false", "0":
+			return []item{false}, nil
+		}
+	case "integer", "int", "long", "short", "byte", "nonNegativeInteger", "positiveInteger",
+		"nonPositiveInteger", "negativeInteger", "unsignedInt", "unsignedLong", "unsignedShort", "unsignedByte":
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return []item{float64(n)}, nil
+		}
+	case "decimal", "double", "float":
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return []item{n}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown function %s()", name)
+	}
+	return nil, fmt.Errorf("'%s' is not a valid xs:%s", value, name)
+}
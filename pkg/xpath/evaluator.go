@@ -0,0 +1,50 @@
+package xpath
+
+import (
+	"bytes"
+	"encoding/xml"
+	"sync"
+)
+
+// Evaluator evaluates XPath tests of xsd:assert and xsd:alternative against go values, such
+// as structs generated by xsd2go. The value is marshalled by encoding/xml and the test is
+// evaluated with the resulting element as context item. Generated structs do not keep
+// namespaces, element and attribute names are therefore matched by local name.
+type Evaluator struct {
+	mu    sync.Mutex
+	cache map[string]*Expr
+}
+
+// Test evaluates effective boolean value of the test against value
+func (ev *Evaluator) Test(value interface{}, test string) (bool, error) {
+	expr, err := ev.compile(test)
+	if err != nil {
+		return false, err
+	}
+	data, err := xml.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	node, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return false, err
+	}
+	return expr.Bool(&Context{Node: node, IgnoreNamespaces: true})
+}
+
+func (ev *Evaluator) compile(test string) (*Expr, error) {
+	ev.mu.Lock()
+	defer ev.mu.Unlock()
+	if expr, found := ev.cache[test]; found {
+		return expr, nil
+	}
+	expr, err := Compile(test)
+	if err != nil {
+		return nil, err
+	}
+	if ev.cache == nil {
+		ev.cache = map[string]*Expr{}
+	}
+	ev.cache[test] = expr
+	return expr, nil
+}
@@ -0,0 +1,82 @@
+// Package xpath evaluates the subset of XPath 2.0 used by XSD 1.1 assertions and type
+// alternatives: relative paths over child, attribute, parent and descendant axes with
+// predicates, literals, $value, arithmetic, general and value comparisons, boolean
+// operators and common functions (count, exists, empty, not, string-length, contains,
+// sum, xs:date and other constructor functions, ...). Without schema types, node values are
+// untyped and compared as numbers when both sides are numeric.
+package xpath
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Node is an element of the document expressions are evaluated against
+type Node struct {
+	Name     xml.Name
+	Attrs    []xml.Attr
+	Children []*Node
+	Parent   *Node
+	// Text is character data directly within the element
+	Text string
+}
+
+// Parse reads XML document into tree of nodes, returns the root element
+func Parse(r io.Reader) (*Node, error) {
+	d := xml.NewDecoder(r)
+	var root *Node
+	var stack []*Node
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &Node{Name: t.Name, Attrs: t.Copy().Attr}
+			if len(stack) == 0 {
+				root = n
+			} else {
+				n.Parent = stack[len(stack)-1]
+				n.Parent.Children = append(n.Parent.Children, n)
+			}
+			stack = append(stack, n)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += string(t)
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("Document contains no root element")
+	}
+	return root, nil
+}
+
+// StringValue concatenates character data of the element and its descendants
+func (n *Node) StringValue() string {
+	var sb strings.Builder
+	n.writeText(&sb)
+	return sb.String()
+}
+
+func (n *Node) writeText(sb *strings.Builder) {
+	sb.WriteString(n.Text)
+	for _, child := range n.Children {
+		child.writeText(sb)
+	}
+}
+
+// attribute is an attribute node selected by attribute axis
+type attribute struct {
+	name   xml.Name
+	value  string
+	parent *Node
+}
@@ -0,0 +1,460 @@
+package xpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr is compiled XPath expression
+type Expr struct {
+	source string
+	root   expr
+}
+
+// String returns source of the expression
+func (e *Expr) String() string {
+	return e.source
+}
+
+type expr interface{}
+
+type (
+	literal     struct{ value item }
+	variable    struct{ name string }
+	contextItem struct{}
+	sequence    struct{ items []expr }
+	negation    struct{ operand expr }
+	binary      struct {
+		op          string
+		left, right expr
+	}
+	call struct {
+		prefix, name string
+		args         []expr
+	}
+	// path applies steps to the result of start, nil start stands for the context item
+	path struct {
+		start expr
+		steps []step
+	}
+	step struct {
+		axis       string
+		prefix     string
+		local      string
+		predicates []expr
+	}
+)
+
+// Compile parses XPath expression
+func Compile(source string) (*Expr, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse XPath '%s': %s", source, err)
+	}
+	p := parser{tokens: tokens}
+	root, err := p.parseExpr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected '%s'", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse XPath '%s': %s", source, err)
+	}
+	return &Expr{source: source, root: root}, nil
+}
+
+type tokenKind int
+
+const (
+	tokName tokenKind = iota
+	tokNumber
+	tokString
+	tokVariable
+	tokOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+var operators = []string{"//", "!=", "<=", ">=", "..", "::", "(", ")", "[", "]", ",", "/", "@", ".", "*", "=", "<", ">", "+", "-", "|"}
+
+// tokenize splits expression into tokens. Names followed by an operand are operators
+// (and, or, div, ...), * is multiplication in such position as well.
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+	src := []rune(source)
+	for i := 0; i < len(src); {
+		r := src[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"':
+			var sb strings.Builder
+			j := i + 1
+			for {
+				if j >= len(src) {
+					return nil, fmt.Errorf("unterminated string literal")
+				}
+				if src[j] == r {
+					if j+1 < len(src) && src[j+1] == r {
+						sb.WriteRune(r)
+						j += 2
+						continue
+					}
+					break
+				}
+				sb.WriteRune(src[j])
+				j++
+			}
+			tokens = append(tokens, token{tokString, sb.String()})
+			i = j + 1
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(src) && unicode.IsDigit(src[i+1])):
+			j := i
+			for j < len(src) && (unicode.IsDigit(src[j]) || src[j] == '.' || src[j] == 'e' || src[j] == 'E') {
+				j++
+			}
+			tokens = append(tokens, token{tokNumber, string(src[i:j])})
+			i = j
+		case r == '$':
+			j := i + 1
+			for j < len(src) && isNameChar(src[j]) {
+				j++
+			}
+			tokens = append(tokens, token{tokVariable, string(src[i+1 : j])})
+			i = j
+		case isNameStart(r):
+			j := i
+			for j < len(src) && (isNameChar(src[j]) || (src[j] == ':' && j+1 < len(src) && isNameStart(src[j+1]) && (j == 0 || src[j-1] != ':'))) {
+				j++
+			}
+			tokens = append(tokens, token{tokName, string(src[i:j])})
+			i = j
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(string(src[i:]), op) {
+					tokens = append(tokens, token{tokOperator, op})
+					i += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character '%c'", r)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+func isNameStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+func isNameChar(r rune) bool {
+	return isNameStart(r) || unicode.IsDigit(r) || r == '-' || r == '.'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() *token {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+// peekOperator tells whether next token is given operator or operator keyword
+func (p *parser) peekOperator(ops ...string) string {
+	t := p.peek()
+	if t == nil || (t.kind != tokOperator && t.kind != tokName) {
+		return ""
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return op
+		}
+	}
+	return ""
+}
+
+func (p *parser) expect(op string) error {
+	if p.peekOperator(op) == "" {
+		if t := p.peek(); t != nil {
+			return fmt.Errorf("expected '%s', found '%s'", op, t.text)
+		}
+		return fmt.Errorf("expected '%s' at the end", op)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseExpr() (expr, error) {
+	first, err := p.parseOr()
+	if err != nil || p.peekOperator(",") == "" {
+		return first, err
+	}
+	seq := sequence{items: []expr{first}}
+	for p.peekOperator(",") != "" {
+		p.pos++
+		next, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		seq.items = append(seq.items, next)
+	}
+	return seq, nil
+}
+
+// parseBinary parses left associative chain of operators of the same precedence
+func (p *parser) parseBinary(operand func() (expr, error), ops ...string) (expr, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peekOperator(ops...)
+		if op == "" {
+			return left, nil
+		}
+		p.pos++
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseOr() (expr, error) {
+	return p.parseBinary(p.parseAnd, "or")
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	return p.parseBinary(p.parseComparison, "and")
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	op := p.peekOperator("=", "!=", "<", "<=", ">", ">=", "eq", "ne", "lt", "le", "gt", "ge")
+	if op == "" {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return binary{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseAdditive() (expr, error) {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *parser) parseMultiplicative() (expr, error) {
+	return p.parseBinary(p.parseUnary, "*", "div", "idiv", "mod")
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.peekOperator("-") != "" {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negation{operand}, nil
+	}
+	if p.peekOperator("+") != "" {
+		p.pos++
+		return p.parseUnary()
+	}
+	return p.parseBinary(p.parsePath, "|", "union")
+}
+
+func (p *parser) parsePath() (expr, error) {
+	var res path
+	switch t := p.peek(); {
+	case t == nil:
+		return nil, fmt.Errorf("unexpected end of expression")
+	case t.kind == tokOperator && (t.text == "/" || t.text == "//"):
+		return nil, fmt.Errorf("absolute paths are not supported")
+	case t.kind == tokNumber || t.kind == tokString || t.kind == tokVariable || t.text == "(" || t.text == "." ||
+		(t.kind == tokName && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "(" && !isNodeTest(t.text)):
+		primary, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		res.start = primary
+		if p.peekOperator("/", "//", "[") == "" {
+			return primary, nil
+		}
+		if p.peekOperator("[") != "" {
+			predicates, err := p.parsePredicates()
+			if err != nil {
+				return nil, err
+			}
+			res.steps = append(res.steps, step{axis: "self", local: "*", predicates: predicates})
+		}
+	default:
+		s, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		res.steps = append(res.steps, s)
+	}
+	for {
+		op := p.peekOperator("/", "//")
+		if op == "" {
+			return res, nil
+		}
+		p.pos++
+		if op == "//" {
+			res.steps = append(res.steps, step{axis: "descendant-or-self", local: "*"})
+		}
+		s, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		res.steps = append(res.steps, s)
+	}
+}
+
+func isNodeTest(name string) bool {
+	return name == "node" || name == "text"
+}
+
+func (p *parser) parseStep() (step, error) {
+	s := step{axis: "child"}
+	if p.peekOperator("..") != "" {
+		p.pos++
+		s.axis, s.local = "parent", "*"
+		return s, nil
+	}
+	if p.peekOperator(".") != "" {
+		p.pos++
+		s.axis, s.local = "self", "*"
+		return s, nil
+	}
+	if p.peekOperator("@") != "" {
+		p.pos++
+		s.axis = "attribute"
+	} else if t := p.peek(); t != nil && t.kind == tokName && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "::" {
+		switch t.text {
+		case "child", "attribute", "parent", "self", "descendant", "descendant-or-self":
+			s.axis = t.text
+		default:
+			return s, fmt.Errorf("axis %s is not supported", t.text)
+		}
+		p.pos += 2
+	}
+	t := p.peek()
+	switch {
+	case t == nil:
+		return s, fmt.Errorf("unexpected end of expression")
+	case t.kind == tokOperator && t.text == "*":
+		s.local = "*"
+		p.pos++
+	case t.kind == tokName && isNodeTest(t.text):
+		p.pos++
+		if err := p.expect("("); err != nil {
+			return s, err
+		}
+		if err := p.expect(")"); err != nil {
+			return s, err
+		}
+		s.local = "*"
+		if t.text == "text" {
+			return s, fmt.Errorf("text() node test is not supported")
+		}
+	case t.kind == tokName:
+		s.prefix, s.local = splitQName(t.text)
+		p.pos++
+	default:
+		return s, fmt.Errorf("unexpected '%s'", t.text)
+	}
+	var err error
+	s.predicates, err = p.parsePredicates()
+	return s, err
+}
+
+func (p *parser) parsePredicates() ([]expr, error) {
+	var res []expr
+	for p.peekOperator("[") != "" {
+		p.pos++
+		predicate, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		res = append(res, predicate)
+	}
+	return res, nil
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.peek()
+	p.pos++
+	switch {
+	case t.kind == tokNumber:
+		value, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s'", t.text)
+		}
+		return literal{value}, nil
+	case t.kind == tokString:
+		return literal{t.text}, nil
+	case t.kind == tokVariable:
+		return variable{t.text}, nil
+	case t.text == ".":
+		return contextItem{}, nil
+	case t.text == "(":
+		if p.peekOperator(")") != "" {
+			p.pos++
+			return sequence{}, nil
+		}
+		inner, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return inner, p.expect(")")
+	default:
+		c := call{}
+		c.prefix, c.name = splitQName(t.text)
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		for p.peekOperator(")") == "" {
+			if len(c.args) > 0 {
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			c.args = append(c.args, arg)
+		}
+		p.pos++
+		return c, nil
+	}
+}
+
+func splitQName(qname string) (string, string) {
+	if idx := strings.Index(qname, ":"); idx != -1 {
+		return qname[:idx], qname[idx+1:]
+	}
+	return "", qname
+}
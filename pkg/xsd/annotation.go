@@ -0,0 +1,33 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Annotation holds human readable documentation attached to XSD components
+type Annotation struct {
+	XMLName        xml.Name        `xml:"http://www.w3.org/2001/XMLSchema annotation"`
+	Documentations []Documentation `xml:"documentation"`
+}
+
+type Documentation struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2001/XMLSchema documentation"`
+	Lang    string   `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Text    string   `xml:",chardata"`
+}
+
+// Documentation returns whitespace-normalized text of all xsd:documentation nodes
+func (a *Annotation) Documentation() string {
+	if a == nil {
+		return ""
+	}
+	var parts []string
+	for _, doc := range a.Documentations {
+		text := strings.Join(strings.Fields(doc.Text), " ")
+		if text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
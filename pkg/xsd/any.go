@@ -0,0 +1,49 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Any is xsd:any wildcard allowing elements not declared by the content model
+type Any struct {
+	XMLName         xml.Name `xml:"http://www.w3.org/2001/XMLSchema any"`
+	Namespace       string   `xml:"namespace,attr,omitempty"`
+	ProcessContents string   `xml:"processContents,attr,omitempty"`
+	MinOccurs       string   `xml:"minOccurs,attr,omitempty"`
+	MaxOccurs       string   `xml:"maxOccurs,attr,omitempty"`
+}
+
+// AnyAttribute is xsd:anyAttribute wildcard allowing undeclared attributes
+type AnyAttribute struct {
+	XMLName         xml.Name `xml:"http://www.w3.org/2001/XMLSchema anyAttribute"`
+	Namespace       string   `xml:"namespace,attr,omitempty"`
+	ProcessContents string   `xml:"processContents,attr,omitempty"`
+}
+
+// wildcardAllows evaluates @namespace constraint of xsd:any or xsd:anyAttribute
+func wildcardAllows(namespaceAttr, targetNamespace, ns string) bool {
+	if namespaceAttr == "" || namespaceAttr == "##any" {
+		return true
+	}
+	if namespaceAttr == "##other" {
+		return ns != targetNamespace && ns != ""
+	}
+	for _, allowed := range strings.Fields(namespaceAttr) {
+		switch allowed {
+		case "##local":
+			if ns == "" {
+				return true
+			}
+		case "##targetNamespace":
+			if ns == targetNamespace {
+				return true
+			}
+		default:
+			if ns == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/gocomply/xsd2go/pkg/xpath"
+)
+
+// selectAlternative returns type of the element chosen by its xsd:alternatives. Tests see
+// the element with its attributes only, as XSD 1.1 requires.
+func (v *validator) selectAlternative(n *node, el *Element) Type {
+	ctx := &xpath.Context{
+		Node:       &xpath.Node{Name: n.name, Attrs: instanceAttrs(n)},
+		Namespaces: el.schema.xpathNamespaces(),
+	}
+	for idx := range el.Alternatives {
+		alt := &el.Alternatives[idx]
+		if alt.Test == "" {
+			return alt.typ
+		}
+		ok, err := evaluateTest(alt.Test, ctx)
+		if err != nil {
+			v.errorf(n, "%s", err)
+			continue
+		}
+		if ok {
+			return alt.typ
+		}
+	}
+	return el.typ
+}
+
+// validateAssertions evaluates xsd:assert of the complex type against the element
+func (v *validator) validateAssertions(n *node, ct *ComplexType) {
+	asserts := ct.Assertions()
+	if len(asserts) == 0 {
+		return
+	}
+	ctx := &xpath.Context{Node: xpathNode(n, nil)}
+	if ct.schema != nil {
+		ctx.Namespaces = ct.schema.xpathNamespaces()
+	}
+	if ct.simpleContentType() != nil {
+		value := collapseWhitespace(n.text)
+		ctx.Value = &value
+	}
+	for _, a := range asserts {
+		if ct.schema != nil {
+			ctx.XPathDefaultNamespace = ct.schema.xpathDefaultNamespace(a.XPathDefaultNamespace)
+		}
+		ok, err := evaluateTest(a.Test, ctx)
+		if err != nil {
+			v.errorf(n, "%s", err)
+		} else if !ok {
+			v.errorf(n, "element %s does not satisfy assertion %s", n.name.Local, a.Test)
+		}
+	}
+}
+
+// checkAssertionFacets evaluates xsd:assertion facets of simple type restriction
+func (r *Restriction) checkAssertionFacets(sch *Schema, value string) error {
+	for _, a := range r.AssertionFacets {
+		ctx := &xpath.Context{Value: &value}
+		if sch != nil {
+			ctx.Namespaces = sch.xpathNamespaces()
+		}
+		ok, err := evaluateTest(a.Test, ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("value '%s' does not satisfy assertion %s", value, a.Test)
+		}
+	}
+	return nil
+}
+
+func evaluateTest(test string, ctx *xpath.Context) (bool, error) {
+	expr, err := xpath.Compile(test)
+	if err != nil {
+		return false, err
+	}
+	return expr.Bool(ctx)
+}
+
+// xpathNode converts element of instance document to the tree assertions are evaluated on
+func xpathNode(n *node, parent *xpath.Node) *xpath.Node {
+	res := &xpath.Node{Name: n.name, Attrs: instanceAttrs(n), Parent: parent, Text: n.text}
+	for _, child := range n.children {
+		res.Children = append(res.Children, xpathNode(child, res))
+	}
+	return res
+}
+
+// instanceAttrs lists attributes of the element without namespace declarations
+func instanceAttrs(n *node) []xml.Attr {
+	var attrs []xml.Attr
+	for _, attr := range n.attrs {
+		if attr.Name.Space != "xmlns" && !(attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
@@ -9,14 +9,19 @@ import (
 
 // Attribute defines single XML attribute
 type Attribute struct {
-	XMLName        xml.Name   `xml:"http://www.w3.org/2001/XMLSchema attribute"`
-	Name           string     `xml:"name,attr"`
-	Type           string     `xml:"type,attr"`
-	Use            string     `xml:"use,attr"`
-	DuplicateCount uint       `xml:"-"`
-	Ref            reference  `xml:"ref,attr"`
-	refAttr        *Attribute `xml:"-"`
-	schema         *Schema    `xml:"-"`
+	XMLName        xml.Name    `xml:"http://www.w3.org/2001/XMLSchema attribute"`
+	Name           string      `xml:"name,attr,omitempty"`
+	Type           string      `xml:"type,attr,omitempty"`
+	Use            string      `xml:"use,attr,omitempty"`
+	Default        string      `xml:"default,attr,omitempty"`
+	Fixed          string      `xml:"fixed,attr,omitempty"`
+	Form           string      `xml:"form,attr,omitempty"`
+	Annotation     *Annotation `xml:"annotation"`
+	SimpleType     *SimpleType `xml:"simpleType"`
+	DuplicateCount uint        `xml:"-"`
+	Ref            Reference   `xml:"ref,attr,omitempty"`
+	refAttr        *Attribute  `xml:"-"`
+	schema         *Schema     `xml:"-"`
 }
 
 // Public Go Name of this struct item
@@ -31,6 +36,13 @@ func (a *Attribute) GoName() string {
 	return strcase.ToCamel(name)
 }
 
+func (a *Attribute) Documentation() string {
+	if a.Annotation == nil && a.refAttr != nil {
+		return a.refAttr.Documentation()
+	}
+	return a.Annotation.Documentation()
+}
+
 func (a *Attribute) Modifiers() string {
 	res := "attr"
 	if a.optional() {
@@ -52,6 +64,9 @@ func (a *Attribute) optional() bool {
 
 func (a *Attribute) compile(s *Schema) {
 	a.schema = s
+	if a.SimpleType != nil {
+		a.SimpleType.compile(s, nil)
+	}
 	if a.Ref != "" {
 		a.refAttr = a.schema.findReferencedAttribute(a.Ref)
 		if a.refAttr == nil {
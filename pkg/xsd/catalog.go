@@ -0,0 +1,214 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Catalog is an OASIS XML Catalog mapping schema locations and namespaces to local files.
+// Supported entries are uri, system, rewriteURI, rewriteSystem and nextCatalog, optionally
+// nested in group. Relative targets are resolved against the catalog file.
+type Catalog struct {
+	path    string
+	entries []catalogEntry
+	next    []*Catalog
+}
+
+type catalogEntry struct {
+	kind   string // uri, system, rewriteURI or rewriteSystem
+	match  string
+	target string
+}
+
+// catalogXML is xml representation of the catalog, group and catalog elements share it
+type catalogXML struct {
+	Base          string         `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
+	URIs          []catalogMatch `xml:"uri"`
+	Systems       []catalogMatch `xml:"system"`
+	RewriteURIs   []catalogMatch `xml:"rewriteURI"`
+	RewriteSystem []catalogMatch `xml:"rewriteSystem"`
+	NextCatalogs  []catalogMatch `xml:"nextCatalog"`
+	Groups        []catalogXML   `xml:"group"`
+}
+
+type catalogMatch struct {
+	Base                string `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
+	Name                string `xml:"name,attr"`
+	SystemId            string `xml:"systemId,attr"`
+	URIStartString      string `xml:"uriStartString,attr"`
+	SystemIdStartString string `xml:"systemIdStartString,attr"`
+	URI                 string `xml:"uri,attr"`
+	RewritePrefix       string `xml:"rewritePrefix,attr"`
+	Catalog             string `xml:"catalog,attr"`
+}
+
+// LoadCatalog reads catalog files from fsys (nil stands for the OS filesystem) together with
+// catalogs they reference by nextCatalog. Several files are consulted in given order.
+func LoadCatalog(fsys fs.FS, paths ...string) (*Catalog, error) {
+	root := &Catalog{}
+	loaded := map[string]*Catalog{}
+	for _, p := range paths {
+		c, err := loadCatalog(fsys, p, loaded)
+		if err != nil {
+			return nil, err
+		}
+		root.next = append(root.next, c)
+	}
+	return root, nil
+}
+
+func loadCatalog(fsys fs.FS, catalogPath string, loaded map[string]*Catalog) (*Catalog, error) {
+	if c, found := loaded[catalogPath]; found {
+		// Catalogs referencing each other are consulted once
+		return c, nil
+	}
+	var data []byte
+	var err error
+	if fsys != nil {
+		data, err = fs.ReadFile(fsys, catalogPath)
+	} else {
+		data, err = os.ReadFile(catalogPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var doc catalogXML
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("Error decoding XML catalog %s: %s", catalogPath, err)
+	}
+
+	c := &Catalog{path: catalogPath}
+	loaded[catalogPath] = c
+	base := catalogDir(fsys, catalogPath)
+	var nextCatalogs []string
+	c.collect(fsys, doc, base, &nextCatalogs)
+	for _, p := range nextCatalogs {
+		next, err := loadCatalog(fsys, p, loaded)
+		if err != nil {
+			return nil, err
+		}
+		c.next = append(c.next, next)
+	}
+	return c, nil
+}
+
+func (c *Catalog) collect(fsys fs.FS, doc catalogXML, base string, nextCatalogs *[]string) {
+	base = rebase(fsys, base, doc.Base)
+	for _, m := range doc.URIs {
+		c.add("uri", m.Name, resolveTarget(fsys, rebase(fsys, base, m.Base), m.URI))
+	}
+	for _, m := range doc.Systems {
+		c.add("system", m.SystemId, resolveTarget(fsys, rebase(fsys, base, m.Base), m.URI))
+	}
+	for _, m := range doc.RewriteURIs {
+		c.add("rewriteURI", m.URIStartString, resolveTarget(fsys, rebase(fsys, base, m.Base), m.RewritePrefix))
+	}
+	for _, m := range doc.RewriteSystem {
+		c.add("rewriteSystem", m.SystemIdStartString, resolveTarget(fsys, rebase(fsys, base, m.Base), m.RewritePrefix))
+	}
+	for _, m := range doc.NextCatalogs {
+		*nextCatalogs = append(*nextCatalogs, resolveTarget(fsys, rebase(fsys, base, m.Base), m.Catalog))
+	}
+	for _, g := range doc.Groups {
+		c.collect(fsys, g, base, nextCatalogs)
+	}
+}
+
+func (c *Catalog) add(kind, match, target string) {
+	c.entries = append(c.entries, catalogEntry{kind: kind, match: match, target: target})
+}
+
+// Resolve maps schema location or namespace to a local path. Exact uri and system entries
+// take precedence over the longest matching rewriteURI or rewriteSystem prefix, catalogs
+// referenced by nextCatalog are consulted last.
+func (c *Catalog) Resolve(location string) (string, bool) {
+	if c == nil || location == "" {
+		return "", false
+	}
+	return c.resolve(location, map[*Catalog]bool{})
+}
+
+func (c *Catalog) resolve(location string, visited map[*Catalog]bool) (string, bool) {
+	if visited[c] {
+		return "", false
+	}
+	visited[c] = true
+	for _, e := range c.entries {
+		if (e.kind == "uri" || e.kind == "system") && e.match == location {
+			return e.target, true
+		}
+	}
+	var best *catalogEntry
+	for idx, e := range c.entries {
+		if (e.kind == "rewriteURI" || e.kind == "rewriteSystem") && strings.HasPrefix(location, e.match) {
+			if best == nil || len(e.match) > len(best.match) {
+				best = &c.entries[idx]
+			}
+		}
+	}
+	if best != nil {
+		return joinTarget(best.target, strings.TrimPrefix(location, best.match)), true
+	}
+	for _, next := range c.next {
+		if target, found := next.resolve(location, visited); found {
+			return target, true
+		}
+	}
+	return "", false
+}
+
+func catalogDir(fsys fs.FS, catalogPath string) string {
+	if fsys != nil {
+		return path.Dir(catalogPath)
+	}
+	return filepath.Dir(catalogPath)
+}
+
+// rebase applies xml:base attribute to the base directory
+func rebase(fsys fs.FS, base, xmlBase string) string {
+	if xmlBase == "" {
+		return base
+	}
+	return resolveTarget(fsys, base, strings.TrimSuffix(xmlBase, "/"))
+}
+
+// resolveTarget turns catalog target to a path: file: URIs are converted to paths, other
+// absolute URIs are kept and relative references are resolved against base
+func resolveTarget(fsys fs.FS, base, target string) string {
+	if u, err := url.Parse(target); err == nil && u.Scheme != "" && len(u.Scheme) > 1 {
+		if u.Scheme == "file" {
+			return filepath.FromSlash(u.Path)
+		}
+		return target
+	}
+	if fsys != nil {
+		return path.Join(base, target)
+	}
+	if filepath.IsAbs(target) {
+		return target
+	}
+	return filepath.Join(base, filepath.FromSlash(target))
+}
+
+func joinTarget(prefix, rest string) string {
+	if isURL(prefix) {
+		return prefix + rest
+	}
+	if rest == "" {
+		return prefix
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(rest, "/")
+}
+
+// isURL tells whether schema location is absolute URI rather than a file path. Single
+// letter schemes are left out as these are windows drive letters.
+func isURL(location string) bool {
+	u, err := url.Parse(location)
+	return err == nil && len(u.Scheme) > 1
+}
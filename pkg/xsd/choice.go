@@ -5,11 +5,43 @@ import (
 )
 
 type Choice struct {
-	XMLName   xml.Name  `xml:"http://www.w3.org/2001/XMLSchema choice"`
-	MinOccurs string    `xml:"minOccurs,attr"`
-	MaxOccurs string    `xml:"maxOccurs,attr"`
-	Elements  []Element `xml:"element"`
-	schema    *Schema   `xml:"-"`
+	XMLName     xml.Name      `xml:"http://www.w3.org/2001/XMLSchema choice"`
+	MinOccurs   string        `xml:"minOccurs,attr,omitempty"`
+	MaxOccurs   string        `xml:"maxOccurs,attr,omitempty"`
+	Annotation  *Annotation   `xml:"annotation"`
+	Elements    []Element     `xml:"element"`
+	Choices     []Choice      `xml:"choice"`
+	Sequences   []Sequence    `xml:"sequence"`
+	Any         []Any         `xml:"any"`
+	order       []particleRef `xml:"-"`
+	allElements []Element     `xml:"-"`
+	schema      *Schema       `xml:"-"`
+}
+
+func (c *Choice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	c.XMLName = start.Name
+	c.MinOccurs, c.MaxOccurs = parseOccursAttrs(start)
+	c.order, err = decodeParticles(d, c.particleSlices())
+	return
+}
+
+func (c Choice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeParticles(e, start, c.MinOccurs, c.MaxOccurs, c.order, c.particleSlices())
+}
+
+// Append adds xsd:element, xsd:choice, xsd:sequence or xsd:any (passed by value) after
+// particles appended so far
+func (c *Choice) Append(particle interface{}) {
+	appendParticle(&c.order, c.particleSlices(), particle)
+}
+
+func (c *Choice) particleSlices() particleSlices {
+	return particleSlices{&c.Annotation, &c.Elements, &c.Choices, &c.Sequences, &c.Any}
+}
+
+// elements lists elements of all branches of the choice, these are all optional in go
+func (c *Choice) elements() []Element {
+	return c.allElements
 }
 
 func (c *Choice) compile(sch *Schema, parentElement *Element) {
@@ -26,4 +58,21 @@ func (c *Choice) compile(sch *Schema, parentElement *Element) {
 			el.MinOccurs = "0"
 		}
 	}
+
+	c.allElements = c.Elements
+	for idx, _ := range c.Choices {
+		inner := &c.Choices[idx]
+		inner.compile(sch, parentElement)
+		c.allElements = append(c.allElements, propagateOccurs(inner.elements(), "0", c.MaxOccurs)...)
+	}
+	for idx, _ := range c.Sequences {
+		inner := &c.Sequences[idx]
+		inner.compile(sch, parentElement)
+		maxOccurs := inner.MaxOccurs
+		if c.MaxOccurs == "unbounded" {
+			maxOccurs = c.MaxOccurs
+		}
+		c.allElements = append(c.allElements, propagateOccurs(inner.Elements(), "0", maxOccurs)...)
+	}
+	c.allElements = uniqueElements(c.allElements)
 }
@@ -6,10 +6,10 @@ import (
 	"github.com/iancoleman/strcase"
 )
 
-// Internal XSD reference. Examples: "xml:lang", "cpe2:platform-specification"
-type reference string
+// Reference is a qualified name referring to other XSD component. Examples: "xml:lang", "cpe2:platform-specification"
+type Reference string
 
-func (ref reference) NsPrefix() string {
+func (ref Reference) NsPrefix() string {
 	colonPos := strings.Index(string(ref), ":")
 	if colonPos == -1 {
 		return ""
@@ -17,11 +17,11 @@ func (ref reference) NsPrefix() string {
 	return string(ref)[0:colonPos]
 }
 
-func (ref reference) Name() string {
+func (ref Reference) Name() string {
 	colonPos := strings.Index(string(ref), ":")
 	return string(ref)[colonPos+1:]
 }
 
-func (ref reference) GoName() string {
+func (ref Reference) GoName() string {
 	return strcase.ToCamel(ref.NsPrefix()) + strcase.ToCamel(ref.Name())
 }
@@ -0,0 +1,57 @@
+package xsd
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Component is a top-level xsd:element or xsd:complexType together with the elements
+// inlined within it, all of which are generated as go structs
+type Component struct {
+	// Name is the go name of the top-level declaration
+	Name         string
+	Elements     []Element
+	ComplexTypes []ComplexType
+}
+
+// Components groups ExportableElements and ExportableComplexTypes by the top-level
+// declaration they come from. Components follow the type order of the schema.
+func (sch *Schema) Components() []Component {
+	var res []Component
+	index := map[string]int{}
+	add := func(name string) *Component {
+		idx, found := index[name]
+		if !found {
+			idx = len(res)
+			index[name] = idx
+			res = append(res, Component{Name: name})
+		}
+		return &res[idx]
+	}
+	for _, el := range sch.Elements {
+		c := add(el.GoName())
+		c.Elements = append(c.Elements, el)
+	}
+	for _, ct := range sch.ExportableComplexTypes() {
+		c := add(ct.GoName())
+		c.ComplexTypes = append(c.ComplexTypes, ct)
+	}
+	for idx, el := range sch.inlinedElements {
+		c := add(sch.inlinedOwners[idx])
+		c.Elements = append(c.Elements, el)
+	}
+	if sch.typeOrder == TypeOrderName {
+		sort.SliceStable(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+		for idx := range res {
+			els := res[idx].Elements
+			sort.SliceStable(els, func(i, j int) bool { return els[i].GoName() < els[j].GoName() })
+		}
+	}
+	return res
+}
+
+// SourceName is the base name of the XSD file the schema was loaded from, without extension
+func (sch *Schema) SourceName() string {
+	return strings.TrimSuffix(filepath.Base(sch.filePath), filepath.Ext(sch.filePath))
+}
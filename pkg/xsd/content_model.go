@@ -0,0 +1,198 @@
+package xsd
+
+// particle is a node of content model used when validating instance documents
+type particle struct {
+	min      int
+	max      int
+	element  *Element
+	wildcard *Any
+	children []*particle
+	choice   bool
+}
+
+// contentModel builds particle tree of the complex type, nil means empty content
+func (ct *ComplexType) contentModel() *particle {
+	if ct.ComplexContent != nil {
+		if ext := ct.ComplexContent.Extension; ext != nil {
+			// Extension appends its own particles after content of the base type
+			var base *particle
+			if baseType, ok := ext.typ.(*ComplexType); ok && baseType != ct {
+				base = baseType.contentModel()
+			}
+			var own *particle
+			if ext.Sequence != nil {
+				own = ext.Sequence.particle()
+			}
+			if base == nil {
+				return own
+			} else if own == nil {
+				return base
+			}
+			return &particle{min: 1, max: 1, children: []*particle{base, own}}
+		}
+		if r := ct.ComplexContent.Restriction; r != nil {
+			// Restriction narrows the content of the base type, base content model is a superset
+			if baseType, ok := ct.schema.LookupType(r.Base).(*ComplexType); ok && baseType != ct {
+				return baseType.contentModel()
+			}
+		}
+		return nil
+	}
+	if ct.Sequence != nil {
+		return ct.Sequence.particle()
+	}
+	if ct.Choice != nil {
+		return ct.Choice.particle()
+	}
+	return nil
+}
+
+func (s *Sequence) particle() *particle {
+	o := occurs{s.MinOccurs, s.MaxOccurs}
+	p := &particle{min: o.minimum(), max: o.maximum()}
+	p.children = modelGroupParticles(s.order, s.particleSlices())
+	return p
+}
+
+func (c *Choice) particle() *particle {
+	o := occurs{c.MinOccurs, c.MaxOccurs}
+	p := &particle{min: o.minimum(), max: o.maximum(), choice: true}
+	p.children = modelGroupParticles(c.order, c.particleSlices())
+	return p
+}
+
+func modelGroupParticles(order []particleRef, src particleSlices) []*particle {
+	if len(order) == 0 {
+		order = defaultParticleOrder(src)
+	}
+	var res []*particle
+	for _, ref := range order {
+		switch ref.kind {
+		case elementParticle:
+			el := &(*src.elements)[ref.idx]
+			o := occurs{el.MinOccurs, el.MaxOccurs}
+			if el.declared != nil {
+				o = *el.declared
+			}
+			res = append(res, &particle{min: o.minimum(), max: o.maximum(), element: el})
+		case choiceParticle:
+			res = append(res, (*src.choices)[ref.idx].particle())
+		case sequenceParticle:
+			res = append(res, (*src.sequences)[ref.idx].particle())
+		case anyParticle:
+			any := &(*src.any)[ref.idx]
+			o := occurs{any.MinOccurs, any.MaxOccurs}
+			res = append(res, &particle{min: o.minimum(), max: o.maximum(), wildcard: any})
+		}
+	}
+	return res
+}
+
+// contentMatcher assigns child elements of an instance node to particles. XSD requires
+// content models to be deterministic (Unique Particle Attribution), greedy matching
+// is therefore sufficient.
+type contentMatcher struct {
+	children []*node
+	targetNs string
+	assigned []*particle
+	furthest int
+	expected []string
+}
+
+func newContentMatcher(children []*node, targetNs string) *contentMatcher {
+	return &contentMatcher{
+		children: children,
+		targetNs: targetNs,
+		assigned: make([]*particle, len(children)),
+	}
+}
+
+// match consumes children starting at pos, returns new position and whether the minimum
+// cardinality of the particle was satisfied
+func (m *contentMatcher) match(p *particle, pos int) (int, bool) {
+	count := 0
+	for p.max == unbounded || count < p.max {
+		next, ok := m.matchOnce(p, pos)
+		if !ok {
+			break
+		}
+		count++
+		if next == pos {
+			// Empty match satisfies any number of remaining repetitions
+			if count < p.min {
+				count = p.min
+			}
+			break
+		}
+		pos = next
+	}
+	return pos, count >= p.min
+}
+
+func (m *contentMatcher) matchOnce(p *particle, pos int) (int, bool) {
+	switch {
+	case p.element != nil || p.wildcard != nil:
+		if pos < len(m.children) && m.accepts(p, m.children[pos]) {
+			m.assigned[pos] = p
+			return pos + 1, true
+		}
+		m.expect(p, pos)
+		return pos, false
+	case p.choice:
+		emptyMatch := false
+		for _, child := range p.children {
+			next, ok := m.match(child, pos)
+			if ok && next > pos {
+				return next, true
+			}
+			m.unassign(pos, next)
+			emptyMatch = emptyMatch || ok
+		}
+		return pos, emptyMatch
+	default:
+		start := pos
+		for _, child := range p.children {
+			var ok bool
+			pos, ok = m.match(child, pos)
+			if !ok {
+				m.unassign(start, pos)
+				return start, false
+			}
+		}
+		return pos, true
+	}
+}
+
+func (m *contentMatcher) unassign(from, to int) {
+	for i := from; i < to && i < len(m.assigned); i++ {
+		m.assigned[i] = nil
+	}
+}
+
+func (m *contentMatcher) accepts(p *particle, n *node) bool {
+	if p.wildcard != nil {
+		return wildcardAllows(p.wildcard.Namespace, m.targetNs, n.name.Space)
+	}
+	return p.element.qualifiedName() == n.name
+}
+
+// expect records what was expected at the furthest position reached, for error reporting
+func (m *contentMatcher) expect(p *particle, pos int) {
+	if pos < m.furthest {
+		return
+	}
+	if pos > m.furthest {
+		m.furthest = pos
+		m.expected = nil
+	}
+	name := "any element"
+	if p.element != nil {
+		name = p.element.XmlName()
+	}
+	for _, e := range m.expected {
+		if e == name {
+			return
+		}
+	}
+	m.expected = append(m.expected, name)
+}
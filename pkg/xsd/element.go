@@ -10,22 +10,33 @@ import (
 
 // Element defines single XML element
 type Element struct {
-	XMLName       xml.Name     `xml:"http://www.w3.org/2001/XMLSchema element"`
-	Name          string       `xml:"name,attr"`
-	nameOverride  string       `xml:"-"`
-	FieldOverride bool         `xml:"-"`
-	Type          reference    `xml:"type,attr"`
-	Ref           reference    `xml:"ref,attr"`
-	MinOccurs     string       `xml:"minOccurs,attr"`
-	MaxOccurs     string       `xml:"maxOccurs,attr"`
-	refElm        *Element     `xml:"-"`
-	ComplexType   *ComplexType `xml:"complexType"`
-	SimpleType    *SimpleType  `xml:"simpleType"`
-	schema        *Schema      `xml:"-"`
-	typ           Type         `xml:"-"`
+	XMLName       xml.Name             `xml:"http://www.w3.org/2001/XMLSchema element"`
+	Name          string               `xml:"name,attr,omitempty"`
+	nameOverride  string               `xml:"-"`
+	FieldOverride bool                 `xml:"-"`
+	Type          Reference            `xml:"type,attr,omitempty"`
+	Ref           Reference            `xml:"ref,attr,omitempty"`
+	MinOccurs     string               `xml:"minOccurs,attr,omitempty"`
+	MaxOccurs     string               `xml:"maxOccurs,attr,omitempty"`
+	Form          string               `xml:"form,attr,omitempty"`
+	Nillable      bool                 `xml:"nillable,attr,omitempty"`
+	declared      *occurs              `xml:"-"`
+	refElm        *Element             `xml:"-"`
+	Annotation    *Annotation          `xml:"annotation"`
+	ComplexType   *ComplexType         `xml:"complexType"`
+	SimpleType    *SimpleType          `xml:"simpleType"`
+	Uniques       []IdentityConstraint `xml:"unique"`
+	Keys          []IdentityConstraint `xml:"key"`
+	KeyRefs       []IdentityConstraint `xml:"keyref"`
+	Alternatives  []Alternative        `xml:"alternative"`
+	schema        *Schema              `xml:"-"`
+	typ           Type                 `xml:"-"`
 }
 
 func (e *Element) Attributes() []Attribute {
+	if e.refElm != nil {
+		return e.refElm.Attributes()
+	}
 	if e.typ != nil {
 		return e.typ.Attributes()
 	}
@@ -33,12 +44,36 @@ func (e *Element) Attributes() []Attribute {
 }
 
 func (e *Element) Elements() []Element {
+	if e.refElm != nil {
+		return e.refElm.Elements()
+	}
 	if e.typ != nil {
 		return e.typ.Elements()
 	}
 	return []Element{}
 }
 
+func (e *Element) Documentation() string {
+	if e.Annotation == nil && e.refElm != nil {
+		return e.refElm.Documentation()
+	}
+	return e.Annotation.Documentation()
+}
+
+// IdentityConstraints returns xsd:unique, xsd:key and xsd:keyref declared on the element, in that order
+func (e *Element) IdentityConstraints() []*IdentityConstraint {
+	if e.refElm != nil {
+		return e.refElm.IdentityConstraints()
+	}
+	var res []*IdentityConstraint
+	for _, list := range [][]IdentityConstraint{e.Uniques, e.Keys, e.KeyRefs} {
+		for idx := range list {
+			res = append(res, &list[idx])
+		}
+	}
+	return res
+}
+
 func (e *Element) GoFieldName() string {
 	name := e.Name
 	if name == "" {
@@ -80,11 +115,12 @@ func (e *Element) GoForeignModule() string {
 	foreignSchema := (*Schema)(nil)
 	if e.refElm != nil {
 		foreignSchema = e.refElm.schema
-	} else if e.typ != nil {
+	} else if _, complex := e.typ.(*ComplexType); complex {
+		// Simple types map to builtin go types, these are never qualified
 		foreignSchema = e.typ.Schema()
 	}
 
-	if foreignSchema != nil && foreignSchema != e.schema {
+	if foreignSchema != nil && foreignSchema.GoPackageName() != e.schema.GoPackageName() {
 		return foreignSchema.GoPackageName() + "."
 	}
 	return ""
@@ -116,6 +152,10 @@ func (e *Element) isArray() bool {
 
 func (e *Element) compile(s *Schema, parentElement *Element) {
 	e.schema = s
+	if e.declared == nil {
+		// Enclosing model groups may override cardinality for the needs of go code
+		e.declared = &occurs{e.MinOccurs, e.MaxOccurs}
+	}
 	if e.ComplexType != nil {
 		e.typ = e.ComplexType
 		if e.SimpleType != nil {
@@ -144,6 +184,23 @@ func (e *Element) compile(s *Schema, parentElement *Element) {
 		}
 	}
 
+	if len(e.Alternatives) > 0 {
+		s.requireXSD11("xsd:alternative")
+	}
+	for idx := range e.Alternatives {
+		e.Alternatives[idx].compile(s, e)
+	}
+
+	for idx := range e.Uniques {
+		e.Uniques[idx].compile(s, e, "unique")
+	}
+	for idx := range e.Keys {
+		e.Keys[idx].compile(s, e, "key")
+	}
+	for idx := range e.KeyRefs {
+		e.KeyRefs[idx].compile(s, e, "keyref")
+	}
+
 	if e.Ref == "" && e.Type == "" && !e.isPlainString() {
 		e.schema.registerInlinedElement(e, parentElement)
 	}
@@ -156,3 +213,9 @@ func (e *Element) prefixNameWithParent(parentElement *Element) {
 		e.nameOverride = fmt.Sprintf("%s-%s", parentElement.GoName(), e.GoName())
 	}
 }
+
+// ContainsInnerXml reports whether go struct used for the element keeps raw inner xml
+func (e *Element) ContainsInnerXml() bool {
+	ct, ok := e.typ.(*ComplexType)
+	return ok && e.Type != "" && ct.ContainsInnerXml()
+}
@@ -5,10 +5,12 @@ import (
 )
 
 type Extension struct {
-	XMLName          xml.Name    `xml:"http://www.w3.org/2001/XMLSchema extension"`
-	Base             reference   `xml:"base,attr"`
-	AttributesDirect []Attribute `xml:"attribute"`
-	Sequence         *Sequence   `xml:"sequence"`
+	XMLName          xml.Name      `xml:"http://www.w3.org/2001/XMLSchema extension"`
+	Base             Reference     `xml:"base,attr,omitempty"`
+	Sequence         *Sequence     `xml:"sequence"`
+	AttributesDirect []Attribute   `xml:"attribute"`
+	AnyAttribute     *AnyAttribute `xml:"anyAttribute"`
+	Asserts          []Assert      `xml:"assert"`
 	typ              Type
 }
 
@@ -81,6 +83,9 @@ func (ext *Extension) ContainsText() bool {
 }
 
 func (ext *Extension) compile(sch *Schema, parentElement *Element) {
+	if len(ext.Asserts) > 0 {
+		sch.requireXSD11("xsd:assert")
+	}
 	if ext.Sequence != nil {
 		ext.Sequence.compile(sch, parentElement)
 	}
@@ -0,0 +1,380 @@
+package xsd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// IdentityConstraint is xsd:key, xsd:keyref or xsd:unique declared on an element
+type IdentityConstraint struct {
+	Name       string      `xml:"name,attr"`
+	Refer      Reference   `xml:"refer,attr,omitempty"`
+	Annotation *Annotation `xml:"annotation"`
+	Selector   XPath       `xml:"selector"`
+	Fields     []XPath     `xml:"field"`
+	kind       string      `xml:"-"`
+	schema     *Schema     `xml:"-"`
+	host       *Element    `xml:"-"`
+	selector   []xpathPath `xml:"-"`
+	fields     [][]xpathPath
+}
+
+// XPath holds @xpath of xsd:selector or xsd:field
+type XPath struct {
+	XPath string `xml:"xpath,attr"`
+}
+
+// xpathStep is a step of the restricted XPath subset allowed in identity constraints
+type xpathStep struct {
+	Descendant bool
+	Attribute  bool
+	Space      string
+	Local      string
+}
+
+type xpathPath []xpathStep
+
+func (ic *IdentityConstraint) compile(sch *Schema, host *Element, kind string) {
+	ic.schema = sch
+	ic.host = host
+	ic.kind = kind
+	var err error
+	ic.selector, err = parseXPath(sch, ic.Selector.XPath, false)
+	if err != nil {
+		panic(fmt.Sprintf("Cannot parse xsd:%s %s selector: %s", kind, ic.Name, err))
+	}
+	ic.fields = nil
+	for _, field := range ic.Fields {
+		paths, err := parseXPath(sch, field.XPath, true)
+		if err != nil {
+			panic(fmt.Sprintf("Cannot parse xsd:%s %s field: %s", kind, ic.Name, err))
+		}
+		ic.fields = append(ic.fields, paths)
+	}
+	sch.registerIdentityConstraint(ic)
+}
+
+// parseXPath parses union of paths such as ".//xccdf:Rule | xccdf:Group" or "@id"
+func parseXPath(sch *Schema, expr string, field bool) ([]xpathPath, error) {
+	var res []xpathPath
+	for _, pathExpr := range strings.Split(expr, "|") {
+		pathExpr = strings.TrimSpace(pathExpr)
+		var path xpathPath
+		descendant := false
+		if strings.HasPrefix(pathExpr, ".//") {
+			descendant = true
+			pathExpr = pathExpr[3:]
+		}
+		for idx, stepExpr := range strings.Split(pathExpr, "/") {
+			stepExpr = strings.TrimSpace(stepExpr)
+			if stepExpr == "." {
+				continue
+			}
+			step := xpathStep{Descendant: descendant && idx == 0}
+			stepExpr = strings.TrimPrefix(stepExpr, "child::")
+			if strings.HasPrefix(stepExpr, "@") || strings.HasPrefix(stepExpr, "attribute::") {
+				if !field {
+					return nil, fmt.Errorf("attribute step not allowed in selector '%s'", expr)
+				}
+				step.Attribute = true
+				stepExpr = strings.TrimPrefix(strings.TrimPrefix(stepExpr, "@"), "attribute::")
+			}
+			if stepExpr == "" {
+				return nil, fmt.Errorf("empty step in '%s'", expr)
+			}
+			ref := Reference(stepExpr)
+			step.Local = ref.Name()
+			if prefix := ref.NsPrefix(); prefix != "" {
+				step.Space = sch.NamespaceByPrefix(prefix)
+				if step.Space == "" {
+					return nil, fmt.Errorf("unknown xmlns prefix '%s' in '%s'", prefix, expr)
+				}
+			}
+			path = append(path, step)
+		}
+		res = append(res, path)
+	}
+	return res, nil
+}
+
+func (ic *IdentityConstraint) Kind() string {
+	return ic.kind
+}
+
+func (ic *IdentityConstraint) IsKeyRef() bool {
+	return ic.kind == "keyref"
+}
+
+func (ic *IdentityConstraint) GoName() string {
+	return strcase.ToCamel(ic.Name)
+}
+
+func (ic *IdentityConstraint) Documentation() string {
+	return ic.Annotation.Documentation()
+}
+
+// Referred returns xsd:key or xsd:unique the xsd:keyref refers to
+func (ic *IdentityConstraint) Referred() *IdentityConstraint {
+	if ic.Refer == "" {
+		return nil
+	}
+	referred := ic.schema.findIdentityConstraint(ic.Refer)
+	if referred == nil {
+		panic("Cannot resolve xsd:keyref/@refer: " + string(ic.Refer))
+	}
+	return referred
+}
+
+// SelectorPaths returns go literal of the parsed selector for use by generated code
+func (ic *IdentityConstraint) SelectorPaths() string {
+	return goPathsLiteral(ic.selector)
+}
+
+// FieldPaths returns go literals of the parsed fields for use by generated code
+func (ic *IdentityConstraint) FieldPaths() []string {
+	var res []string
+	for _, field := range ic.fields {
+		res = append(res, goPathsLiteral(field))
+	}
+	return res
+}
+
+func goPathsLiteral(paths []xpathPath) string {
+	var b strings.Builder
+	b.WriteString("[][]identityStep{")
+	for _, path := range paths {
+		b.WriteString("{")
+		for _, step := range path {
+			fmt.Fprintf(&b, "{descendant: %t, attribute: %t, name: %q},", step.Descendant, step.Attribute, step.Local)
+		}
+		b.WriteString("},")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// GoTargetType returns go type of the elements selected by the constraint, or
+// interface{} when elements of different types may be selected
+func (ic *IdentityConstraint) GoTargetType() string {
+	goType := ""
+	for _, el := range ic.targets() {
+		if el.isPlainString() || el.typ == nil || len(el.Elements()) == 0 && len(el.Attributes()) == 0 {
+			return "interface{}"
+		}
+		t := "*" + el.GoForeignModule() + el.GoTypeName()
+		if goType != "" && goType != t {
+			return "interface{}"
+		}
+		goType = t
+	}
+	if goType == "" {
+		return "interface{}"
+	}
+	return goType
+}
+
+// targets statically evaluates selector against the content models
+func (ic *IdentityConstraint) targets() []Element {
+	var res []Element
+	for _, path := range ic.selector {
+		current := []Element{*ic.host}
+		for _, step := range path {
+			if step.Descendant {
+				current = descendantsOrSelf(current)
+			}
+			var next []Element
+			for _, el := range current {
+				for _, child := range el.Elements() {
+					if step.Local == "*" || child.XmlName() == step.Local {
+						next = append(next, child)
+					}
+				}
+			}
+			current = next
+		}
+		res = append(res, current...)
+	}
+	return res
+}
+
+func descendantsOrSelf(elements []Element) []Element {
+	seen := map[string]bool{}
+	var res []Element
+	queue := elements
+	for len(queue) > 0 {
+		el := queue[0]
+		queue = queue[1:]
+		key := el.XmlName() + " " + el.GoForeignModule() + el.GoTypeName()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		res = append(res, el)
+		queue = append(queue, el.Elements()...)
+	}
+	return res
+}
+
+func (sch *Schema) registerIdentityConstraint(ic *IdentityConstraint) {
+	if sch.identityConstraints == nil {
+		sch.identityConstraints = map[string]*IdentityConstraint{}
+	}
+	sch.identityConstraints[ic.Name] = ic
+}
+
+func (sch *Schema) findIdentityConstraint(ref Reference) *IdentityConstraint {
+	innerSchema := sch.findReferencedSchemaByPrefix(ref.NsPrefix())
+	if innerSchema == nil || innerSchema.identityConstraints == nil {
+		return nil
+	}
+	return innerSchema.identityConstraints[ref.Name()]
+}
+
+// HasIdentityConstraints reports whether any element of the schema declares key, keyref or unique
+func (sch *Schema) HasIdentityConstraints() bool {
+	for _, el := range sch.ExportableElements() {
+		if len(el.IdentityConstraints()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate selects nodes of the instance document matching the paths
+func evaluatePaths(n *node, paths []xpathPath) (nodes []*node, values []string) {
+	for _, path := range paths {
+		current := []*node{n}
+		for idx, step := range path {
+			if step.Descendant {
+				current = instanceDescendantsOrSelf(current)
+			}
+			if step.Attribute {
+				if idx != len(path)-1 {
+					break
+				}
+				for _, c := range current {
+					for _, attr := range c.attrs {
+						if (step.Local == "*" || attr.Name.Local == step.Local) && attr.Name.Space == step.Space {
+							values = append(values, attr.Value)
+						}
+					}
+				}
+				current = nil
+				break
+			}
+			var next []*node
+			for _, c := range current {
+				for _, child := range c.children {
+					if (step.Local == "*" || child.name.Local == step.Local) && (step.Space == child.name.Space || step.Local == "*" && step.Space == "") {
+						next = append(next, child)
+					}
+				}
+			}
+			current = next
+		}
+		nodes = append(nodes, current...)
+	}
+	return
+}
+
+func instanceDescendantsOrSelf(nodes []*node) []*node {
+	var res []*node
+	var walk func(n *node)
+	walk = func(n *node) {
+		res = append(res, n)
+		for _, child := range n.children {
+			walk(child)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	return res
+}
+
+// identityTable holds key values collected for single xsd:key or xsd:unique at a host node
+type identityTable struct {
+	host   *node
+	decl   *IdentityConstraint
+	values map[string]bool
+}
+
+func (v *validator) validateIdentityConstraints(n *node, el *Element) {
+	for _, ic := range el.IdentityConstraints() {
+		ic := ic
+		selected, _ := evaluatePaths(n, ic.selector)
+		table := identityTable{host: n, decl: ic, values: map[string]bool{}}
+		for _, target := range selected {
+			value, complete := v.fieldValues(target, ic)
+			if !complete {
+				if ic.kind == "key" {
+					v.errorf(target, "xsd:key %s: element %s does not define all key fields", ic.Name, target.name.Local)
+				}
+				continue
+			}
+			switch ic.kind {
+			case "key", "unique":
+				if table.values[value] {
+					v.errorf(target, "xsd:%s %s: duplicate value '%s'", ic.kind, ic.Name, value)
+				}
+				table.values[value] = true
+			case "keyref":
+				referred := ic.Referred()
+				if !v.keyExists(n, referred, value) {
+					v.errorf(target, "xsd:keyref %s: value '%s' does not match any xsd:%s %s", ic.Name, value, referred.kind, referred.Name)
+				}
+			}
+		}
+		if ic.kind != "keyref" {
+			v.tables = append(v.tables, table)
+		}
+	}
+}
+
+func (v *validator) fieldValues(target *node, ic *IdentityConstraint) (string, bool) {
+	var values []string
+	for _, field := range ic.fields {
+		nodes, attrValues := evaluatePaths(target, field)
+		for _, n := range nodes {
+			attrValues = append(attrValues, n.text)
+		}
+		if len(attrValues) != 1 {
+			if len(attrValues) > 1 {
+				v.errorf(target, "xsd:%s %s: field selects multiple values", ic.kind, ic.Name)
+			}
+			return "", false
+		}
+		values = append(values, strings.TrimSpace(attrValues[0]))
+	}
+	return strings.Join(values, " "), true
+}
+
+// keyExists looks up the value in tables of referred key evaluated within the subtree of n
+func (v *validator) keyExists(n *node, referred *IdentityConstraint, value string) bool {
+	for _, table := range v.tables {
+		if table.decl == referred && isWithin(table.host, n) && table.values[value] {
+			return true
+		}
+	}
+	return false
+}
+
+func isWithin(n, ancestor *node) bool {
+	for ; n != nil; n = n.parent {
+		if n == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferredInScope returns constraint the xsd:keyref refers to when it is declared on the same element
+func (ic *IdentityConstraint) ReferredInScope() *IdentityConstraint {
+	referred := ic.Referred()
+	if referred == nil || referred.host != ic.host {
+		return nil
+	}
+	return referred
+}
@@ -0,0 +1,110 @@
+package xsd
+
+// IDKind returns "ID", "IDREF" or "IDREFS" when the attribute type derives from xsd:ID,
+// xsd:IDREF or xsd:IDREFS, empty string otherwise
+func (a *Attribute) IDKind() string {
+	return idKind(a.resolvedType())
+}
+
+// IDKind returns "ID", "IDREF" or "IDREFS" when the element has simple type derived from
+// xsd:ID, xsd:IDREF or xsd:IDREFS, empty string otherwise
+func (e *Element) IDKind() string {
+	if e.refElm != nil {
+		return e.refElm.IDKind()
+	}
+	if _, ok := e.typ.(*ComplexType); ok || e.typ == nil {
+		return ""
+	}
+	return idKind(e.typ)
+}
+
+// ContainsIDs reports whether the generated struct, or any struct of the same package nested
+// in it, carries xsd:ID or xsd:IDREF(S) values
+func (e *Element) ContainsIDs() bool {
+	return containsIDs(e.Attributes(), e.Elements(), map[string]bool{})
+}
+
+// ContainsIDs reports whether the generated struct, or any struct of the same package nested
+// in it, carries xsd:ID or xsd:IDREF(S) values
+func (ct *ComplexType) ContainsIDs() bool {
+	return containsIDs(ct.Attributes(), ct.Elements(), map[string]bool{})
+}
+
+// DeclaresIDs reports whether the generated struct itself carries xsd:ID value
+func (e *Element) DeclaresIDs() bool {
+	return declaresIDs(e.Attributes(), e.Elements())
+}
+
+// DeclaresIDs reports whether the generated struct itself carries xsd:ID value
+func (ct *ComplexType) DeclaresIDs() bool {
+	return declaresIDs(ct.Attributes(), ct.Elements())
+}
+
+// HasIDs reports whether any struct generated for the schema carries xsd:ID or xsd:IDREF(S) values
+func (sch *Schema) HasIDs() bool {
+	for _, el := range sch.ExportableElements() {
+		if el.ContainsIDs() {
+			return true
+		}
+	}
+	for _, ct := range sch.ExportableComplexTypes() {
+		if ct.ContainsIDs() {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIDs(attributes []Attribute, elements []Element, seen map[string]bool) bool {
+	for idx := range attributes {
+		if attributes[idx].IDKind() != "" {
+			return true
+		}
+	}
+	for idx := range elements {
+		el := &elements[idx]
+		if el.IDKind() != "" {
+			return true
+		}
+		if el.GoForeignModule() != "" || seen[el.GoTypeName()] {
+			continue
+		}
+		seen[el.GoTypeName()] = true
+		if containsIDs(el.Attributes(), el.Elements(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func declaresIDs(attributes []Attribute, elements []Element) bool {
+	for idx := range attributes {
+		if attributes[idx].IDKind() == "ID" {
+			return true
+		}
+	}
+	for idx := range elements {
+		if elements[idx].IDKind() == "ID" {
+			return true
+		}
+	}
+	return false
+}
+
+func idKind(typ Type) string {
+	switch t := typ.(type) {
+	case staticType:
+		switch t {
+		case "ID", "IDREF", "IDREFS":
+			return string(t)
+		}
+	case *SimpleType:
+		if t.Restriction != nil {
+			return idKind(t.Restriction.baseType(t.schema))
+		}
+		if t.List != nil && idKind(t.List.itemType(t.schema)) == "IDREF" {
+			return "IDREFS"
+		}
+	}
+	return ""
+}
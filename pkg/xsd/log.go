@@ -0,0 +1,58 @@
+package xsd
+
+import (
+	"fmt"
+	"io"
+)
+
+// Level of detail of progress messages
+type Level int
+
+const (
+	// LevelQuiet reports nothing
+	LevelQuiet Level = iota
+	// LevelInfo reports processed and generated files
+	LevelInfo
+	// LevelVerbose additionally reports resolution decisions, renames and skipped constructs
+	LevelVerbose
+)
+
+// Logger receives progress messages of the workspace and the generator
+type Logger interface {
+	Infof(format string, v ...interface{})
+	Verbosef(format string, v ...interface{})
+}
+
+// NewLogger returns Logger writing messages up to given level to w, one per line
+func NewLogger(w io.Writer, level Level) Logger {
+	return &writerLogger{w: w, level: level}
+}
+
+type writerLogger struct {
+	w     io.Writer
+	level Level
+}
+
+func (l *writerLogger) Infof(format string, v ...interface{}) {
+	l.printf(LevelInfo, format, v...)
+}
+
+func (l *writerLogger) Verbosef(format string, v ...interface{}) {
+	l.printf(LevelVerbose, format, v...)
+}
+
+func (l *writerLogger) printf(level Level, format string, v ...interface{}) {
+	if l.level >= level {
+		fmt.Fprintf(l.w, format+"\n", v...)
+	}
+}
+
+// OrDiscard returns the logger, or one discarding all messages in place of nil logger
+func OrDiscard(logger Logger) Logger {
+	if logger == nil {
+		return quietLogger
+	}
+	return logger
+}
+
+var quietLogger = NewLogger(io.Discard, LevelQuiet)
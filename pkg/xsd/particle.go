@@ -0,0 +1,209 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+const unbounded = -1
+
+// occurs holds minOccurs and maxOccurs as declared in the XSD
+type occurs struct {
+	min string
+	max string
+}
+
+func (o occurs) minimum() int {
+	if o.min == "" {
+		return 1
+	}
+	n, err := strconv.Atoi(o.min)
+	if err != nil {
+		return 1
+	}
+	return n
+}
+
+func (o occurs) maximum() int {
+	if o.max == "" {
+		return 1
+	}
+	if o.max == "unbounded" {
+		return unbounded
+	}
+	n, err := strconv.Atoi(o.max)
+	if err != nil {
+		return 1
+	}
+	return n
+}
+
+type particleKind int
+
+const (
+	elementParticle particleKind = iota
+	choiceParticle
+	sequenceParticle
+	anyParticle
+)
+
+// particleRef remembers position of xsd:sequence or xsd:choice child within the
+// per-kind slices, so that the content model keeps declaration order.
+type particleRef struct {
+	kind particleKind
+	idx  int
+}
+
+// particleSlices points to the slices of a model group the particles are decoded into
+type particleSlices struct {
+	annotation **Annotation
+	elements   *[]Element
+	choices    *[]Choice
+	sequences  *[]Sequence
+	any        *[]Any
+}
+
+func decodeParticles(d *xml.Decoder, dst particleSlices) ([]particleRef, error) {
+	var order []particleRef
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Space != xsdNamespace {
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			switch t.Name.Local {
+			case "annotation":
+				*dst.annotation = &Annotation{}
+				err = d.DecodeElement(*dst.annotation, &t)
+			case "element":
+				*dst.elements = append(*dst.elements, Element{})
+				err = d.DecodeElement(&(*dst.elements)[len(*dst.elements)-1], &t)
+				order = append(order, particleRef{elementParticle, len(*dst.elements) - 1})
+			case "choice":
+				*dst.choices = append(*dst.choices, Choice{})
+				err = d.DecodeElement(&(*dst.choices)[len(*dst.choices)-1], &t)
+				order = append(order, particleRef{choiceParticle, len(*dst.choices) - 1})
+			case "sequence":
+				*dst.sequences = append(*dst.sequences, Sequence{})
+				err = d.DecodeElement(&(*dst.sequences)[len(*dst.sequences)-1], &t)
+				order = append(order, particleRef{sequenceParticle, len(*dst.sequences) - 1})
+			case "any":
+				*dst.any = append(*dst.any, Any{})
+				err = d.DecodeElement(&(*dst.any)[len(*dst.any)-1], &t)
+				order = append(order, particleRef{anyParticle, len(*dst.any) - 1})
+			default:
+				err = d.Skip()
+			}
+			if err != nil {
+				return nil, err
+			}
+		case xml.EndElement:
+			return order, nil
+		}
+	}
+}
+
+func defaultParticleOrder(dst particleSlices) []particleRef {
+	var order []particleRef
+	for idx := range *dst.elements {
+		order = append(order, particleRef{elementParticle, idx})
+	}
+	for idx := range *dst.choices {
+		order = append(order, particleRef{choiceParticle, idx})
+	}
+	for idx := range *dst.sequences {
+		order = append(order, particleRef{sequenceParticle, idx})
+	}
+	for idx := range *dst.any {
+		order = append(order, particleRef{anyParticle, idx})
+	}
+	return order
+}
+
+// appendParticle adds Element, Choice, Sequence or Any to the model group after particles
+// added so far, so that schemas built in memory keep declaration order when written
+func appendParticle(order *[]particleRef, dst particleSlices, particle interface{}) {
+	if len(*order) == 0 {
+		*order = defaultParticleOrder(dst)
+	}
+	switch p := particle.(type) {
+	case Element:
+		*dst.elements = append(*dst.elements, p)
+		*order = append(*order, particleRef{elementParticle, len(*dst.elements) - 1})
+	case Choice:
+		*dst.choices = append(*dst.choices, p)
+		*order = append(*order, particleRef{choiceParticle, len(*dst.choices) - 1})
+	case Sequence:
+		*dst.sequences = append(*dst.sequences, p)
+		*order = append(*order, particleRef{sequenceParticle, len(*dst.sequences) - 1})
+	case Any:
+		*dst.any = append(*dst.any, p)
+		*order = append(*order, particleRef{anyParticle, len(*dst.any) - 1})
+	default:
+		panic(fmt.Sprintf("Internal error: %T is not a particle", particle))
+	}
+}
+
+func encodeParticles(e *xml.Encoder, start xml.StartElement, minOccurs, maxOccurs string, order []particleRef, src particleSlices) error {
+	start.Attr = occursAttrs(minOccurs, maxOccurs)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if *src.annotation != nil {
+		if err := e.Encode(*src.annotation); err != nil {
+			return err
+		}
+	}
+	if len(order) == 0 {
+		order = defaultParticleOrder(src)
+	}
+	for _, p := range order {
+		var err error
+		switch p.kind {
+		case elementParticle:
+			err = e.Encode(&(*src.elements)[p.idx])
+		case choiceParticle:
+			// Model groups built in memory have no XMLName to derive the tag from
+			err = e.EncodeElement(&(*src.choices)[p.idx], xml.StartElement{Name: xml.Name{Space: xsdNamespace, Local: "choice"}})
+		case sequenceParticle:
+			err = e.EncodeElement(&(*src.sequences)[p.idx], xml.StartElement{Name: xml.Name{Space: xsdNamespace, Local: "sequence"}})
+		case anyParticle:
+			err = e.Encode(&(*src.any)[p.idx])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func parseOccursAttrs(start xml.StartElement) (minOccurs, maxOccurs string) {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "minOccurs":
+			minOccurs = attr.Value
+		case "maxOccurs":
+			maxOccurs = attr.Value
+		}
+	}
+	return
+}
+
+func occursAttrs(minOccurs, maxOccurs string) []xml.Attr {
+	var attrs []xml.Attr
+	if minOccurs != "" {
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "minOccurs"}, Value: minOccurs})
+	}
+	if maxOccurs != "" {
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "maxOccurs"}, Value: maxOccurs})
+	}
+	return attrs
+}
@@ -0,0 +1,239 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+)
+
+// redefinedSuffix is appended to the name of component replaced by xsd:redefine. The original
+// stays resolvable as base of its redefinition, but is not generated. The suffix cannot occur
+// in NCName, so it cannot clash with declared names.
+const redefinedSuffix = "#redefined"
+
+// Redefine is xsd:redefine or XSD 1.1 xsd:override. Components of the referenced schema
+// become part of the redefining schema, those declared within replace the originals.
+type Redefine struct {
+	XMLName        xml.Name
+	SchemaLocation string        `xml:"schemaLocation,attr"`
+	Elements       []Element     `xml:"element"`
+	Attributes     []Attribute   `xml:"attribute"`
+	ComplexTypes   []ComplexType `xml:"complexType"`
+	SimpleTypes    []SimpleType  `xml:"simpleType"`
+}
+
+func (r *Redefine) override() bool {
+	return r.XMLName.Local == "override"
+}
+
+// applyRedefines merges schemas referenced by xsd:redefine and xsd:override into the schema.
+// Paths of the redefined schemas are mapped to the redefining schema, so that imports of the
+// redefined schema elsewhere in the workspace see the effective components.
+func (ws *Workspace) applyRedefines(sch *Schema) error {
+	redefines := append(append([]Redefine{}, sch.Redefines...), sch.Overrides...)
+	for idx := range redefines {
+		r := &redefines[idx]
+		if r.override() && ws.xsdVersion == XSDVersion10 {
+			return &VersionError{Construct: "xsd:override", SchemaPath: sch.filePath}
+		}
+		location, err := ws.locate(sch.filePath, "", r.SchemaLocation)
+		if err != nil {
+			return err
+		}
+		key := ws.cacheKey(location)
+		if loaded, found := ws.Cache[key]; found && loaded != sch {
+			return fmt.Errorf("Schema %s redefined by %s has already been loaded on its own, load %s first", location, sch.filePath, sch.filePath)
+		}
+		ws.Cache[key] = sch
+		ws.logger.Verbosef("\txsd:%s of %s in %s", r.XMLName.Local, location, sch.filePath)
+
+		base, err := ws.parseXsd(location)
+		if err != nil {
+			return err
+		}
+		if base.TargetNamespace != "" && base.TargetNamespace != sch.TargetNamespace {
+			return fmt.Errorf("Schema %s cannot redefine %s of different target namespace '%s'", sch.filePath, location, base.TargetNamespace)
+		}
+		if err := ws.applyRedefines(base); err != nil {
+			return err
+		}
+		for idx := range base.Imports {
+			if err := base.Imports[idx].load(ws, location); err != nil {
+				return err
+			}
+		}
+		if err := sch.mergeRedefined(base, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeRedefined adds components of the redefined schema in front of the schema's own
+func (sch *Schema) mergeRedefined(base *Schema, r *Redefine) error {
+	for _, decl := range base.Xmlns {
+		uri := sch.Xmlns.UriByPrefix(decl.Prefix)
+		if uri == "" {
+			sch.Xmlns = append(sch.Xmlns, decl)
+		} else if uri != decl.Uri {
+			return fmt.Errorf("Prefix '%s' is bound to '%s' in %s, but to '%s' in redefined %s", decl.Prefix, uri, sch.filePath, decl.Uri, base.filePath)
+		}
+	}
+	sch.Imports = append(sch.Imports, base.Imports...)
+
+	if !r.override() && (len(r.Elements) > 0 || len(r.Attributes) > 0) {
+		return fmt.Errorf("xsd:redefine of %s in %s may redefine only types, use xsd:override for elements and attributes", base.filePath, sch.filePath)
+	}
+	replaced := map[string]bool{}
+	var elements []Element
+	for _, el := range base.Elements {
+		if idx := redefinedElement(r.Elements, el.Name); idx != -1 {
+			el = r.Elements[idx]
+			replaced["element "+el.Name] = true
+		}
+		elements = append(elements, el)
+	}
+	var attributes []Attribute
+	for _, attr := range base.Attributes {
+		if idx := redefinedAttribute(r.Attributes, attr.Name); idx != -1 {
+			attr = r.Attributes[idx]
+			replaced["attribute "+attr.Name] = true
+		}
+		attributes = append(attributes, attr)
+	}
+	var complexTypes, originalComplexTypes []ComplexType
+	for _, ct := range base.ComplexTypes {
+		if idx := redefinedComplexType(r.ComplexTypes, ct.Name); idx != -1 {
+			redefinition := r.ComplexTypes[idx]
+			if !r.override() && sch.rebaseRedefinition(&redefinition, ct.Name) {
+				original := ct
+				original.Name += redefinedSuffix
+				original.redefined = true
+				originalComplexTypes = append(originalComplexTypes, original)
+			}
+			ct = redefinition
+			replaced["complexType "+ct.Name] = true
+		}
+		complexTypes = append(complexTypes, ct)
+	}
+	var simpleTypes, originalSimpleTypes []SimpleType
+	for _, st := range base.SimpleTypes {
+		if idx := redefinedSimpleType(r.SimpleTypes, st.Name); idx != -1 {
+			redefinition := r.SimpleTypes[idx]
+			if !r.override() && redefinition.Restriction != nil && sch.redefinesItself(redefinition.Restriction.Base, st.Name) {
+				original := st
+				original.Name += redefinedSuffix
+				originalSimpleTypes = append(originalSimpleTypes, original)
+				redefinition.Restriction.Base += redefinedSuffix
+			}
+			st = redefinition
+			replaced["simpleType "+st.Name] = true
+		}
+		simpleTypes = append(simpleTypes, st)
+	}
+	sch.Elements = append(elements, sch.Elements...)
+	sch.Attributes = append(attributes, sch.Attributes...)
+	sch.ComplexTypes = append(append(complexTypes, sch.ComplexTypes...), originalComplexTypes...)
+	sch.SimpleTypes = append(append(simpleTypes, sch.SimpleTypes...), originalSimpleTypes...)
+
+	for _, el := range r.Elements {
+		if !replaced["element "+el.Name] {
+			sch.Logger().Verbosef("\txsd:override of element '%s' not declared in %s, skipped", el.Name, base.filePath)
+		}
+	}
+	for _, attr := range r.Attributes {
+		if !replaced["attribute "+attr.Name] {
+			sch.Logger().Verbosef("\txsd:override of attribute '%s' not declared in %s, skipped", attr.Name, base.filePath)
+		}
+	}
+	for _, ct := range r.ComplexTypes {
+		if !replaced["complexType "+ct.Name] {
+			if !r.override() {
+				return fmt.Errorf("xsd:redefine in %s redefines complexType '%s' not declared in %s", sch.filePath, ct.Name, base.filePath)
+			}
+			sch.Logger().Verbosef("\txsd:override of complexType '%s' not declared in %s, skipped", ct.Name, base.filePath)
+		}
+	}
+	for _, st := range r.SimpleTypes {
+		if !replaced["simpleType "+st.Name] {
+			if !r.override() {
+				return fmt.Errorf("xsd:redefine in %s redefines simpleType '%s' not declared in %s", sch.filePath, st.Name, base.filePath)
+			}
+			sch.Logger().Verbosef("\txsd:override of simpleType '%s' not declared in %s, skipped", st.Name, base.filePath)
+		}
+	}
+	return nil
+}
+
+// redefinesItself tells whether base of a redefinition refers to the redefined component
+func (sch *Schema) redefinesItself(base string, name string) bool {
+	ref := Reference(base)
+	return ref.Name() == name && sch.xmlnsByPrefixInternal(ref.NsPrefix()) == sch.TargetNamespace
+}
+
+// rebaseRedefinition points base of complex type redefinition referring to itself to the
+// original definition. Returns false when the redefinition does not refer to itself.
+func (sch *Schema) rebaseRedefinition(ct *ComplexType, name string) bool {
+	var ext *Extension
+	var restriction *Restriction
+	if ct.ComplexContent != nil {
+		ext, restriction = ct.ComplexContent.Extension, ct.ComplexContent.Restriction
+	} else if ct.SimpleContent != nil {
+		ext = ct.SimpleContent.Extension
+	}
+	if ext != nil && sch.redefinesItself(string(ext.Base), name) {
+		ext.Base += redefinedSuffix
+		return true
+	}
+	if restriction != nil && sch.redefinesItself(restriction.Base, name) {
+		restriction.Base += redefinedSuffix
+		return true
+	}
+	return false
+}
+
+// componentName is the go name of the top-level declaration the complex type is generated as
+// part of, originals replaced by xsd:redefine belong to their redefinition
+func (ct *ComplexType) componentName() string {
+	if ct.redefined {
+		return strcase.ToCamel(ct.Name[:len(ct.Name)-len(redefinedSuffix)])
+	}
+	return ct.GoName()
+}
+
+func redefinedElement(elements []Element, name string) int {
+	for idx := range elements {
+		if elements[idx].Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+func redefinedAttribute(attributes []Attribute, name string) int {
+	for idx := range attributes {
+		if attributes[idx].Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+func redefinedComplexType(types []ComplexType, name string) int {
+	for idx := range types {
+		if types[idx].Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+func redefinedSimpleType(types []SimpleType, name string) int {
+	for idx := range types {
+		if types[idx].Name == name {
+			return idx
+		}
+	}
+	return -1
+}
@@ -5,12 +5,43 @@ import (
 )
 
 type Restriction struct {
-	XMLName    xml.Name    `xml:"http://www.w3.org/2001/XMLSchema restriction"`
-	Base       string      `xml:"base,attr"`
-	Attributes []Attribute `xml:"attribute"`
+	XMLName        xml.Name      `xml:"http://www.w3.org/2001/XMLSchema restriction"`
+	Base           string        `xml:"base,attr,omitempty"`
+	SimpleType     *SimpleType   `xml:"simpleType"`
+	Enumerations   []Enumeration `xml:"enumeration"`
+	Patterns       []Facet       `xml:"pattern"`
+	Length         *Facet        `xml:"length"`
+	MinLength      *Facet        `xml:"minLength"`
+	MaxLength      *Facet        `xml:"maxLength"`
+	MinInclusive   *Facet        `xml:"minInclusive"`
+	MaxInclusive   *Facet        `xml:"maxInclusive"`
+	MinExclusive   *Facet        `xml:"minExclusive"`
+	MaxExclusive   *Facet        `xml:"maxExclusive"`
+	TotalDigits    *Facet        `xml:"totalDigits"`
+	FractionDigits *Facet        `xml:"fractionDigits"`
+	WhiteSpace     *Facet        `xml:"whiteSpace"`
+	Attributes     []Attribute   `xml:"attribute"`
+	AnyAttribute   *AnyAttribute `xml:"anyAttribute"`
+	// Asserts are xsd:assert of complex type restriction
+	Asserts []Assert `xml:"assert"`
+	// AssertionFacets are xsd:assertion facets of simple type restriction
+	AssertionFacets []Assert `xml:"assertion"`
+}
+
+type Enumeration struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2001/XMLSchema enumeration"`
+	Value   string   `xml:"value,attr"`
+}
+
+// Facet constrains value space of simple type, such as xsd:maxLength or xsd:pattern
+type Facet struct {
+	Value string `xml:"value,attr"`
 }
 
 func (r *Restriction) compile(sch *Schema) {
+	if len(r.Asserts) > 0 {
+		sch.requireXSD11("xsd:assert")
+	}
 	for idx, _ := range r.Attributes {
 		attribute := &r.Attributes[idx]
 		attribute.compile(sch)
@@ -11,18 +11,31 @@ import (
 
 // Schema is the root XSD element
 type Schema struct {
-	XMLName         xml.Name           `xml:"http://www.w3.org/2001/XMLSchema schema"`
-	Xmlns           Xmlns              `xml:"-"`
-	TargetNamespace string             `xml:"targetNamespace,attr"`
-	Imports         []Import           `xml:"import"`
-	Elements        []Element          `xml:"element"`
-	Attributes      []Attribute        `xml:"attribute"`
-	ComplexTypes    []ComplexType      `xml:"complexType"`
-	SimpleTypes     []SimpleType       `xml:"simpleType"`
-	importedModules map[string]*Schema `xml:"-"`
-	ModulesPath     string             `xml:"-"`
-	filePath        string             `xml:"-"`
-	inlinedElements []Element          `xml:"-"`
+	XMLName              xml.Name                       `xml:"http://www.w3.org/2001/XMLSchema schema"`
+	Xmlns                Xmlns                          `xml:"-"`
+	TargetNamespace      string                         `xml:"targetNamespace,attr,omitempty"`
+	ElementFormDefault   string                         `xml:"elementFormDefault,attr,omitempty"`
+	AttributeFormDefault string                         `xml:"attributeFormDefault,attr,omitempty"`
+	Imports              []Import                       `xml:"import"`
+	Redefines            []Redefine                     `xml:"redefine"`
+	Overrides            []Redefine                     `xml:"override"`
+	DefaultOpenContent   *OpenContent                   `xml:"defaultOpenContent"`
+	Elements             []Element                      `xml:"element"`
+	Attributes           []Attribute                    `xml:"attribute"`
+	ComplexTypes         []ComplexType                  `xml:"complexType"`
+	SimpleTypes          []SimpleType                   `xml:"simpleType"`
+	importedModules      map[string]*Schema             `xml:"-"`
+	importOrder          []*Schema                      `xml:"-"`
+	typeOrder            string                         `xml:"-"`
+	ModulesPath          string                         `xml:"-"`
+	goPackage            string                         `xml:"-"`
+	filePath             string                         `xml:"-"`
+	inlinedElements      []Element                      `xml:"-"`
+	inlinedOwners        []string                       `xml:"-"`
+	compiling            string                         `xml:"-"`
+	xsdVersion           string                         `xml:"-"`
+	identityConstraints  map[string]*IdentityConstraint `xml:"-"`
+	logger               Logger                         `xml:"-"`
 }
 
 func parseSchema(f io.Reader) (*Schema, error) {
@@ -45,21 +58,70 @@ func (sch *Schema) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 }
 
 func (sch *Schema) compile() {
+	if sch.DefaultOpenContent != nil {
+		sch.requireXSD11("xsd:defaultOpenContent")
+	}
 	for idx, _ := range sch.Elements {
 		el := &sch.Elements[idx]
+		sch.compiling = el.GoName()
 		el.compile(sch, nil)
 	}
 	for idx, _ := range sch.ComplexTypes {
 		ct := &sch.ComplexTypes[idx]
+		sch.compiling = ct.componentName()
 		ct.compile(sch, nil)
 	}
+	sch.compiling = ""
 	for idx, _ := range sch.SimpleTypes {
 		st := &sch.SimpleTypes[idx]
 		st.compile(sch, nil)
 	}
+	for idx, _ := range sch.Attributes {
+		attr := &sch.Attributes[idx]
+		attr.compile(sch)
+	}
+	for _, ct := range sch.ExportableComplexTypes() {
+		if el := sch.elementByGoName(ct.GoName()); el != nil {
+			sch.Logger().Verbosef("\txsd:complexType '%s' shares go name with xsd:element '%s', only the element is generated", ct.Name, el.Name)
+		}
+	}
+}
+
+// compileChecked compiles the schema, references to namespaces imported without
+// schemaLocation that could not be resolved are reported as UnresolvedNamespaceError and
+// XSD 1.1 constructs in schemas processed as XSD 1.0 as VersionError
+func (sch *Schema) compileChecked() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch e := r.(type) {
+			case *UnresolvedNamespaceError:
+				err = e
+			case *VersionError:
+				err = e
+			default:
+				panic(r)
+			}
+		}
+	}()
+	sch.compile()
+	return nil
+}
+
+func (sch *Schema) elementByGoName(goName string) *Element {
+	for idx, el := range sch.Elements {
+		if el.GoName() == goName {
+			return &sch.Elements[idx]
+		}
+	}
+	return nil
 }
 
-func (sch *Schema) findReferencedAttribute(ref reference) *Attribute {
+// Logger returns logger of the workspace the schema was loaded by
+func (sch *Schema) Logger() Logger {
+	return OrDiscard(sch.logger)
+}
+
+func (sch *Schema) findReferencedAttribute(ref Reference) *Attribute {
 	innerSchema := sch.findReferencedSchemaByPrefix(ref.NsPrefix())
 	if innerSchema == nil {
 		panic("Internal error: referenced attribute '" + ref + "' cannot be found.")
@@ -67,7 +129,7 @@ func (sch *Schema) findReferencedAttribute(ref reference) *Attribute {
 	return innerSchema.GetAttribute(ref.Name())
 }
 
-func (sch *Schema) findReferencedElement(ref reference) *Element {
+func (sch *Schema) findReferencedElement(ref Reference) *Element {
 	innerSchema := sch.findReferencedSchemaByPrefix(ref.NsPrefix())
 	if innerSchema == nil {
 		panic("Internal error: referenced element '" + string(ref) + "' cannot be found.")
@@ -79,11 +141,11 @@ func (sch *Schema) findReferencedElement(ref reference) *Element {
 	return innerSchema.GetElement(ref.Name())
 }
 
-func (sch *Schema) findReferencedType(ref reference) Type {
+func (sch *Schema) findReferencedType(ref Reference) Type {
 	innerSchema := sch.findReferencedSchemaByPrefix(ref.NsPrefix())
 	if innerSchema == nil {
 		xmlnsUri := sch.Xmlns.UriByPrefix(ref.NsPrefix())
-		if xmlnsUri == "http://www.w3.org/2001/XMLSchema" {
+		if xmlnsUri == xsdNamespace {
 			return StaticType(ref.Name())
 		}
 		panic("Internal error: referenced type '" + string(ref) + "' cannot be found.")
@@ -94,13 +156,68 @@ func (sch *Schema) findReferencedType(ref reference) Type {
 	return innerSchema.GetType(ref.Name())
 }
 
+// LookupType resolves qualified type name (such as "xsd:string" or "cpe2:platformType")
+// as seen from this schema. Returns nil when the type cannot be found.
+func (sch *Schema) LookupType(ref string) Type {
+	r := Reference(ref)
+	uri := sch.xmlnsByPrefixInternal(r.NsPrefix())
+	if uri == xsdNamespace {
+		if typ, found := lookupStaticType(r.Name()); found {
+			return typ
+		}
+		return nil
+	}
+	innerSchema := sch.findReferencedSchemaByXmlns(uri)
+	if innerSchema == nil {
+		return nil
+	}
+	return innerSchema.GetType(r.Name())
+}
+
+// LookupElement resolves qualified top-level element name as seen from this schema.
+// Returns nil when the element cannot be found.
+func (sch *Schema) LookupElement(ref string) *Element {
+	r := Reference(ref)
+	innerSchema := sch.findReferencedSchemaByXmlns(sch.xmlnsByPrefixInternal(r.NsPrefix()))
+	if innerSchema == nil {
+		return nil
+	}
+	return innerSchema.GetElement(r.Name())
+}
+
+// LookupAttribute resolves qualified top-level attribute name as seen from this schema.
+// Returns nil when the attribute cannot be found.
+func (sch *Schema) LookupAttribute(ref string) *Attribute {
+	r := Reference(ref)
+	innerSchema := sch.findReferencedSchemaByXmlns(sch.xmlnsByPrefixInternal(r.NsPrefix()))
+	if innerSchema == nil {
+		return nil
+	}
+	return innerSchema.GetAttribute(r.Name())
+}
+
+// NamespaceByPrefix returns namespace URI bound to given xmlns prefix, or empty string
+func (sch *Schema) NamespaceByPrefix(prefix string) string {
+	return sch.xmlnsByPrefixInternal(prefix)
+}
+
 func (sch *Schema) findReferencedSchemaByPrefix(xmlnsPrefix string) *Schema {
-	return sch.findReferencedSchemaByXmlns(sch.xmlnsByPrefix(xmlnsPrefix))
+	uri := sch.xmlnsByPrefix(xmlnsPrefix)
+	res := sch.findReferencedSchemaByXmlns(uri)
+	if res == nil {
+		for _, imp := range sch.Imports {
+			if imp.Namespace == uri && imp.SchemaLocation == "" && imp.ImportedSchema == nil {
+				panic(&UnresolvedNamespaceError{Namespace: uri, SchemaPath: sch.filePath})
+			}
+		}
+	}
+	return res
 }
 
 func (sch *Schema) xmlnsByPrefix(xmlnsPrefix string) string {
 	uri := sch.xmlnsByPrefixInternal(xmlnsPrefix)
-	if uri == "" {
+	if uri == "" && xmlnsPrefix != "" {
+		// Unprefixed references of schema without targetNamespace are not in any namespace
 		panic("Internal error: Unknown xmlns prefix: " + xmlnsPrefix)
 	}
 	return uri
@@ -115,7 +232,7 @@ func (sch *Schema) xmlnsByPrefixInternal(xmlnsPrefix string) string {
 	default:
 		uri := sch.Xmlns.UriByPrefix(xmlnsPrefix)
 		if uri == "" {
-			for _, imported := range sch.importedModules {
+			for _, imported := range sch.importOrder {
 				uri = imported.xmlnsByPrefixInternal(xmlnsPrefix)
 				if uri != "" {
 					return uri
@@ -124,7 +241,6 @@ func (sch *Schema) xmlnsByPrefixInternal(xmlnsPrefix string) string {
 		}
 		return uri
 	}
-	return ""
 }
 
 func (sch *Schema) findReferencedSchemaByXmlns(xmlns string) *Schema {
@@ -136,7 +252,7 @@ func (sch *Schema) findReferencedSchemaByXmlns(xmlns string) *Schema {
 			return imp.ImportedSchema
 		}
 	}
-	for _, imp := range sch.importedModules {
+	for _, imp := range sch.importOrder {
 		s := imp.findReferencedSchemaByXmlns(xmlns)
 		if s != nil {
 			return s
@@ -149,8 +265,25 @@ func (sch *Schema) Empty() bool {
 	return len(sch.Elements) == 0 && len(sch.ComplexTypes) == 0
 }
 
+// Orderings of generated types, see SetTypeOrder
+const (
+	TypeOrderDeclaration = "declaration"
+	TypeOrderName        = "name"
+)
+
+// SetTypeOrder selects order of ExportableElements and ExportableComplexTypes: declaration
+// order (default, inlined elements follow top-level ones) or sorted by go name. Order of
+// struct fields always follows the schema as it determines order of marshalled elements.
+func (sch *Schema) SetTypeOrder(order string) {
+	sch.typeOrder = order
+}
+
 func (sch *Schema) ExportableElements() []Element {
-	return append(sch.Elements, sch.inlinedElements...)
+	res := append(append([]Element{}, sch.Elements...), sch.inlinedElements...)
+	if sch.typeOrder == TypeOrderName {
+		sort.SliceStable(res, func(i, j int) bool { return res[i].GoName() < res[j].GoName() })
+	}
+	return res
 }
 
 func (sch *Schema) ExportableComplexTypes() []ComplexType {
@@ -162,10 +295,13 @@ func (sch *Schema) ExportableComplexTypes() []ComplexType {
 	var res []ComplexType
 	for _, typ := range sch.ComplexTypes {
 		_, found := elCache[typ.GoName()]
-		if !found {
+		if !found && !typ.redefined {
 			res = append(res, typ)
 		}
 	}
+	if sch.typeOrder == TypeOrderName {
+		sort.SliceStable(res, func(i, j int) bool { return res[i].GoName() < res[j].GoName() })
+	}
 	return res
 }
 
@@ -189,7 +325,7 @@ func (sch *Schema) GetElement(name string) *Element {
 
 func (sch *Schema) GetType(name string) Type {
 	if name == "string" || name == "base64Binary" {
-		return StaticType("string")
+		return StaticType(name)
 	}
 	for idx, typ := range sch.ComplexTypes {
 		if typ.Name == name {
@@ -205,6 +341,9 @@ func (sch *Schema) GetType(name string) Type {
 }
 
 func (sch *Schema) GoPackageName() string {
+	if sch.goPackage != "" {
+		return sch.goPackage
+	}
 	xmlnsPrefix := sch.Xmlns.PrefixByUri(sch.TargetNamespace)
 	if xmlnsPrefix == "" {
 		xmlnsPrefix = strings.TrimSuffix(filepath.Base(sch.filePath), ".xsd")
@@ -212,16 +351,45 @@ func (sch *Schema) GoPackageName() string {
 	return strings.ReplaceAll(xmlnsPrefix, "-", "_")
 }
 
+// ShareGoPackage generates the schema into given go package together with other schemas, such
+// as schemas of namespaces that refer to each other's types
+func (sch *Schema) ShareGoPackage(name string) {
+	sch.goPackage = name
+}
+
+// SharesGoPackage tells whether the schema was moved to shared go package by ShareGoPackage
+func (sch *Schema) SharesGoPackage() bool {
+	return sch.goPackage != ""
+}
+
 func (sch *Schema) GoImportsNeeded() []string {
 	imports := []string{"encoding/xml"}
-	for _, importedMod := range sch.importedModules {
+	for _, importedMod := range sch.importOrder {
+		if importedMod.GoPackageName() == sch.GoPackageName() {
+			// Schemas sharing the go package, see Options.Layout
+			continue
+		}
 		imports = append(imports, fmt.Sprintf("%s/%s", sch.ModulesPath, importedMod.GoPackageName()))
 	}
 	sort.Strings(imports)
 	return imports
 }
 
+// GoImportedPackages lists names of other go packages the generated code refers to
+func (sch *Schema) GoImportedPackages() []string {
+	var packages []string
+	for _, importedMod := range sch.importOrder {
+		if importedMod.GoPackageName() != sch.GoPackageName() {
+			packages = append(packages, importedMod.GoPackageName())
+		}
+	}
+	return packages
+}
+
 func (sch *Schema) registerImportedModule(module *Schema) {
+	if _, found := sch.importedModules[module.GoPackageName()]; !found {
+		sch.importOrder = append(sch.importOrder, module)
+	}
 	sch.importedModules[module.GoPackageName()] = module
 }
 
@@ -240,20 +408,38 @@ func (sch *Schema) registerInlinedElement(el *Element, parentElement *Element) {
 			panic("Not implemented: found inlined xsd:element without @name attribute")
 		}
 		el.prefixNameWithParent(parentElement)
+		if parentElement != nil {
+			sch.Logger().Verbosef("\tInlined xsd:element '%s' within '%s' generated as %s", el.Name, parentElement.Name, el.GoName())
+		}
 		sch.inlinedElements = append(sch.inlinedElements, *el)
+		sch.inlinedOwners = append(sch.inlinedOwners, sch.compiling)
 	}
 }
 
 type Import struct {
 	XMLName        xml.Name `xml:"http://www.w3.org/2001/XMLSchema import"`
-	Namespace      string   `xml:"namespace,attr"`
-	SchemaLocation string   `xml:"schemaLocation,attr"`
+	Namespace      string   `xml:"namespace,attr,omitempty"`
+	SchemaLocation string   `xml:"schemaLocation,attr,omitempty"`
 	ImportedSchema *Schema  `xml:"-"`
 }
 
-func (i *Import) load(ws *Workspace, baseDir string) (err error) {
-	if i.SchemaLocation != "" {
-		i.ImportedSchema, err = ws.loadXsd(filepath.Join(baseDir, i.SchemaLocation))
+func (i *Import) load(ws *Workspace, referencingPath string) (err error) {
+	if i.SchemaLocation == "" {
+		if loaded := ws.schemaByNamespace(i.Namespace); loaded != nil {
+			ws.logger.Verbosef("\tImport of namespace '%s' in %s satisfied by already loaded %s", i.Namespace, referencingPath, loaded.filePath)
+			i.ImportedSchema = loaded
+			return
+		}
+	}
+	location, err := ws.locate(referencingPath, i.Namespace, i.SchemaLocation)
+	if err != nil {
+		return err
+	}
+	if location == "" {
+		ws.logger.Verbosef("\tImport of namespace '%s' in %s has no schemaLocation nor matching schema, skipped", i.Namespace, referencingPath)
+		return
 	}
+	ws.logger.Verbosef("\tImport of namespace '%s' in %s resolved to %s", i.Namespace, referencingPath, location)
+	i.ImportedSchema, err = ws.loadXsd(location)
 	return
 }
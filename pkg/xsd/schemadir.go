@@ -0,0 +1,106 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// schemaDirIndex maps targetNamespace to path of the first *.xsd declaring it found in
+// WorkspaceOptions.SchemaDirs. The directories are scanned on first use.
+func (ws *Workspace) schemaDirIndex() (map[string]string, error) {
+	if ws.namespaceIndex != nil {
+		return ws.namespaceIndex, nil
+	}
+	ws.namespaceIndex = map[string]string{}
+	for _, dir := range ws.schemaDirs {
+		walk := func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !strings.HasSuffix(path, ".xsd") {
+				return nil
+			}
+			ns, err := ws.peekTargetNamespace(path)
+			if err != nil {
+				ws.logger.Verbosef("\tSkipping %s found in schema directory: %s", path, err)
+				return nil
+			}
+			if previous, found := ws.namespaceIndex[ns]; found {
+				ws.logger.Verbosef("\tNamespace '%s' declared by both %s and %s, using the former", ns, previous, path)
+				return nil
+			}
+			ws.namespaceIndex[ns] = path
+			return nil
+		}
+		var err error
+		if ws.fsys != nil {
+			err = fs.WalkDir(ws.fsys, dir, walk)
+		} else {
+			err = filepath.WalkDir(dir, walk)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ws.namespaceIndex, nil
+}
+
+// peekTargetNamespace reads targetNamespace of the xsd:schema root element
+func (ws *Workspace) peekTargetNamespace(path string) (string, error) {
+	var f io.ReadCloser
+	var err error
+	if ws.fsys != nil {
+		f, err = ws.fsys.Open(path)
+	} else {
+		f, err = os.Open(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	d := xml.NewDecoder(f)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if start.Name.Space != xsdNamespace || start.Name.Local != "schema" {
+				return "", io.ErrUnexpectedEOF
+			}
+			for _, attr := range start.Attr {
+				if attr.Name.Space == "" && attr.Name.Local == "targetNamespace" {
+					return attr.Value, nil
+				}
+			}
+			return "", nil
+		}
+	}
+}
+
+// schemaByNamespace finds already loaded schema of given target namespace
+func (ws *Workspace) schemaByNamespace(namespace string) *Schema {
+	for _, sch := range ws.loadOrder {
+		if sch.TargetNamespace == namespace {
+			return sch
+		}
+	}
+	return nil
+}
+
+// UnresolvedNamespaceError reports reference to a namespace imported without schemaLocation
+// that could not be resolved
+type UnresolvedNamespaceError struct {
+	Namespace  string
+	SchemaPath string
+}
+
+func (e *UnresolvedNamespaceError) Error() string {
+	return "Cannot resolve namespace '" + e.Namespace + "' imported by " + e.SchemaPath +
+		" without schemaLocation: no loaded schema, XML catalog entry nor schema in schema directories declares it"
+}
@@ -5,10 +5,37 @@ import (
 )
 
 type Sequence struct {
-	XMLName     xml.Name  `xml:"http://www.w3.org/2001/XMLSchema sequence"`
-	ElementList []Element `xml:"element"`
-	Choices     []Choice  `xml:"choice"`
-	allElements []Element `xml:"-"`
+	XMLName     xml.Name      `xml:"http://www.w3.org/2001/XMLSchema sequence"`
+	MinOccurs   string        `xml:"minOccurs,attr,omitempty"`
+	MaxOccurs   string        `xml:"maxOccurs,attr,omitempty"`
+	Annotation  *Annotation   `xml:"annotation"`
+	ElementList []Element     `xml:"element"`
+	Choices     []Choice      `xml:"choice"`
+	Sequences   []Sequence    `xml:"sequence"`
+	Any         []Any         `xml:"any"`
+	order       []particleRef `xml:"-"`
+	allElements []Element     `xml:"-"`
+}
+
+func (s *Sequence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	s.XMLName = start.Name
+	s.MinOccurs, s.MaxOccurs = parseOccursAttrs(start)
+	s.order, err = decodeParticles(d, s.particleSlices())
+	return
+}
+
+func (s Sequence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeParticles(e, start, s.MinOccurs, s.MaxOccurs, s.order, s.particleSlices())
+}
+
+// Append adds xsd:element, xsd:choice, xsd:sequence or xsd:any (passed by value) after
+// particles appended so far
+func (s *Sequence) Append(particle interface{}) {
+	appendParticle(&s.order, s.particleSlices(), particle)
+}
+
+func (s *Sequence) particleSlices() particleSlices {
+	return particleSlices{&s.Annotation, &s.ElementList, &s.Choices, &s.Sequences, &s.Any}
 }
 
 func (s *Sequence) Elements() []Element {
@@ -26,6 +53,42 @@ func (s *Sequence) compile(sch *Schema, parentElement *Element) {
 		c := &s.Choices[idx]
 		c.compile(sch, parentElement)
 
-		s.allElements = append(s.allElements, c.Elements...)
+		s.allElements = append(s.allElements, c.elements()...)
+	}
+	for idx, _ := range s.Sequences {
+		inner := &s.Sequences[idx]
+		inner.compile(sch, parentElement)
+
+		s.allElements = append(s.allElements, propagateOccurs(inner.Elements(), inner.MinOccurs, inner.MaxOccurs)...)
+	}
+	s.allElements = uniqueElements(s.allElements)
+}
+
+// propagateOccurs applies cardinality of a nested model group to its elements
+func propagateOccurs(elements []Element, minOccurs, maxOccurs string) []Element {
+	res := make([]Element, 0, len(elements))
+	for _, el := range elements {
+		if maxOccurs == "unbounded" {
+			el.MaxOccurs = "unbounded"
+		}
+		if minOccurs == "0" {
+			el.MinOccurs = "0"
+		}
+		res = append(res, el)
+	}
+	return res
+}
+
+// uniqueElements drops elements appearing in multiple branches of the content model
+func uniqueElements(elements []Element) []Element {
+	seen := make(map[string]struct{}, len(elements))
+	res := make([]Element, 0, len(elements))
+	for _, element := range elements {
+		if _, ok := seen[element.GoFieldName()]; ok {
+			continue
+		}
+		seen[element.GoFieldName()] = struct{}{}
+		res = append(res, element)
 	}
+	return res
 }
@@ -0,0 +1,82 @@
+package xsd
+
+import (
+	"strings"
+)
+
+// StreamableElement is repeated xsd:element that generated code can decode one at a time
+type StreamableElement struct {
+	Element
+	// Path lists element names from the document root down to the element
+	Path     []string
+	funcName string
+}
+
+// GoStreamName is the name of the generated streaming function
+func (se *StreamableElement) GoStreamName() string {
+	return se.funcName
+}
+
+// StreamableElements lists repeated elements of generated structs reachable from global
+// elements of the schema, each with the shortest path leading to it
+func (sch *Schema) StreamableElements() []StreamableElement {
+	type queued struct {
+		el   Element
+		path []string
+	}
+	var queue []queued
+	for _, el := range sch.Elements {
+		queue = append(queue, queued{el, []string{el.XmlName()}})
+	}
+
+	var res []StreamableElement
+	names := map[string]string{}
+	visited := map[string]bool{}
+	for len(queue) > 0 {
+		q := queue[0]
+		queue = queue[1:]
+		for _, child := range q.el.Elements() {
+			if child.GoForeignModule() != "" || child.isPlainString() || len(child.Elements()) == 0 && len(child.Attributes()) == 0 {
+				continue
+			}
+			path := append(append([]string{}, q.path...), child.XmlName())
+			if child.isArray() {
+				if name := sch.streamName(child, names); name != "" {
+					res = append(res, StreamableElement{Element: child, Path: path, funcName: name})
+				}
+			}
+			if !visited[child.GoTypeName()] {
+				visited[child.GoTypeName()] = true
+				queue = append(queue, queued{child, path})
+			}
+		}
+	}
+	return res
+}
+
+// streamName picks unique function name for the element, returns empty string when the
+// element of the same go type has been already listed
+func (sch *Schema) streamName(el Element, names map[string]string) string {
+	for _, candidate := range []string{el.GoFieldName(), el.GoTypeName()} {
+		name := "Stream" + plural(candidate)
+		if goType, found := names[name]; found {
+			if goType == el.GoTypeName() {
+				return ""
+			}
+			continue
+		}
+		names[name] = el.GoTypeName()
+		return name
+	}
+	return ""
+}
+
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "s") || strings.HasSuffix(name, "x") || strings.HasSuffix(name, "ch") || strings.HasSuffix(name, "sh"):
+		return name + "es"
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return name[:len(name)-1] + "ies"
+	}
+	return name + "s"
+}
@@ -18,15 +18,21 @@ type Type interface {
 
 type ComplexType struct {
 	XMLName          xml.Name        `xml:"http://www.w3.org/2001/XMLSchema complexType"`
-	Name             string          `xml:"name,attr"`
-	Mixed            bool            `xml:"mixed,attr"`
-	AttributesDirect []Attribute     `xml:"attribute"`
-	Sequence         *Sequence       `xml:"sequence"`
-	schema           *Schema         `xml:"-"`
+	Name             string          `xml:"name,attr,omitempty"`
+	Mixed            bool            `xml:"mixed,attr,omitempty"`
+	Annotation       *Annotation     `xml:"annotation"`
 	SimpleContent    *SimpleContent  `xml:"simpleContent"`
 	ComplexContent   *ComplexContent `xml:"complexContent"`
+	Sequence         *Sequence       `xml:"sequence"`
 	Choice           *Choice         `xml:"choice"`
+	AttributesDirect []Attribute     `xml:"attribute"`
+	AnyAttribute     *AnyAttribute   `xml:"anyAttribute"`
+	Asserts          []Assert        `xml:"assert"`
+	OpenContent      *OpenContent    `xml:"openContent"`
+	schema           *Schema         `xml:"-"`
 	content          GenericContent  `xml:"-"`
+	// redefined marks original definition replaced by xsd:redefine, see redefinedSuffix
+	redefined bool
 }
 
 func (ct *ComplexType) Attributes() []Attribute {
@@ -42,11 +48,15 @@ func (ct *ComplexType) Elements() []Element {
 	} else if ct.content != nil {
 		return ct.content.Elements()
 	} else if ct.Choice != nil {
-		return ct.Choice.Elements
+		return ct.Choice.elements()
 	}
 	return []Element{}
 }
 
+func (ct *ComplexType) Documentation() string {
+	return ct.Annotation.Documentation()
+}
+
 func (ct *ComplexType) GoName() string {
 	return strcase.ToCamel(ct.Name)
 }
@@ -69,6 +79,12 @@ func (ct *ComplexType) Schema() *Schema {
 
 func (ct *ComplexType) compile(sch *Schema, parentElement *Element) {
 	ct.schema = sch
+	if len(ct.Asserts) > 0 {
+		sch.requireXSD11("xsd:assert")
+	}
+	if ct.OpenContent != nil {
+		sch.requireXSD11("xsd:openContent")
+	}
 	if ct.Sequence != nil {
 		ct.Sequence.compile(sch, parentElement)
 	}
@@ -84,6 +100,9 @@ func (ct *ComplexType) compile(sch *Schema, parentElement *Element) {
 		count += 1
 		goNames[attribute.GoName()] = count
 		attribute.DuplicateCount = count
+		if count >= 2 {
+			sch.Logger().Verbosef("\tAttribute '%s' of xsd:complexType '%s' renamed to %s to avoid name clash", attribute.Name, ct.Name, attribute.GoName())
+		}
 		// Second GoName may be different depending on the DuplicateCount
 		goNames[attribute.GoName()] = count
 	}
@@ -119,9 +138,29 @@ func (ct *ComplexType) compile(sch *Schema, parentElement *Element) {
 }
 
 type SimpleType struct {
-	XMLName xml.Name `xml:"http://www.w3.org/2001/XMLSchema simpleType"`
-	Name    string   `xml:"name,attr"`
-	schema  *Schema  `xml:"-"`
+	XMLName     xml.Name     `xml:"http://www.w3.org/2001/XMLSchema simpleType"`
+	Name        string       `xml:"name,attr,omitempty"`
+	Annotation  *Annotation  `xml:"annotation"`
+	Restriction *Restriction `xml:"restriction"`
+	List        *List        `xml:"list"`
+	Union       *Union       `xml:"union"`
+	schema      *Schema      `xml:"-"`
+}
+
+type List struct {
+	XMLName    xml.Name    `xml:"http://www.w3.org/2001/XMLSchema list"`
+	ItemType   string      `xml:"itemType,attr,omitempty"`
+	SimpleType *SimpleType `xml:"simpleType"`
+}
+
+type Union struct {
+	XMLName     xml.Name     `xml:"http://www.w3.org/2001/XMLSchema union"`
+	MemberTypes string       `xml:"memberTypes,attr,omitempty"`
+	SimpleTypes []SimpleType `xml:"simpleType"`
+}
+
+func (st *SimpleType) Documentation() string {
+	return st.Annotation.Documentation()
 }
 
 func (st *SimpleType) GoName() string {
@@ -138,6 +177,20 @@ func (st *SimpleType) Schema() *Schema {
 
 func (st *SimpleType) compile(sch *Schema, parentElement *Element) {
 	st.schema = sch
+	if st.Restriction != nil && len(st.Restriction.AssertionFacets) > 0 {
+		sch.requireXSD11("xsd:assertion")
+	}
+	if st.Restriction != nil && st.Restriction.SimpleType != nil {
+		st.Restriction.SimpleType.compile(sch, parentElement)
+	}
+	if st.List != nil && st.List.SimpleType != nil {
+		st.List.SimpleType.compile(sch, parentElement)
+	}
+	if st.Union != nil {
+		for idx, _ := range st.Union.SimpleTypes {
+			st.Union.SimpleTypes[idx].compile(sch, parentElement)
+		}
+	}
 }
 
 func (st *SimpleType) Attributes() []Attribute {
@@ -152,10 +205,12 @@ func (st *SimpleType) ContainsText() bool {
 	return true
 }
 
+// staticType is a built-in XSD type such as xsd:string or xsd:dateTime
 type staticType string
 
 func (st staticType) GoName() string {
-	return string(st)
+	goName, _ := goBuiltinType(string(st))
+	return goName
 }
 
 func (ct staticType) GoTypeName() string {
@@ -182,17 +237,53 @@ func (st staticType) compile(*Schema, *Element) {
 }
 
 func StaticType(name string) staticType {
-	if name == "string" || name == "dateTime" || name == "base64Binary" || name == "normalizedString" || name == "token" || name == "NCName" || name == "anySimpleType" {
-		return staticType("string")
-	} else if name == "int" {
-		return "int"
-	} else if name == "integer" {
-		return "int64"
-	} else if name == "decimal" {
-		return "float64"
-	} else if name == "boolean" {
-		return "bool"
+	typ, found := lookupStaticType(name)
+	if !found {
+		panic("Type xsd:" + name + " not implemented")
+	}
+	return typ
+}
+
+func lookupStaticType(name string) (staticType, bool) {
+	_, found := goBuiltinType(name)
+	return staticType(name), found
+}
+
+// BuiltinGoType maps built-in XSD type such as "dateTime" to go type, reports false for
+// types not supported
+func BuiltinGoType(name string) (string, bool) {
+	return goBuiltinType(name)
+}
+
+// goBuiltinType maps built-in XSD type to go type
+func goBuiltinType(name string) (string, bool) {
+	switch name {
+	case "string", "dateTime", "date", "time", "base64Binary", "normalizedString", "token", "NCName", "anySimpleType",
+		"anyURI", "ID", "IDREF", "IDREFS", "NMTOKEN", "NMTOKENS", "Name", "QName", "language", "duration", "hexBinary",
+		"gYear", "gYearMonth", "gMonth", "gMonthDay", "gDay":
+		return "string", true
+	case "int":
+		return "int", true
+	case "integer", "long", "nonNegativeInteger", "positiveInteger", "nonPositiveInteger", "negativeInteger":
+		return "int64", true
+	case "short":
+		return "int16", true
+	case "byte":
+		return "int8", true
+	case "unsignedLong":
+		return "uint64", true
+	case "unsignedInt":
+		return "uint32", true
+	case "unsignedShort":
+		return "uint16", true
+	case "unsignedByte":
+		return "uint8", true
+	case "decimal", "double":
+		return "float64", true
+	case "float":
+		return "float32", true
+	case "boolean":
+		return "bool", true
 	}
-	panic("Type xsd:" + name + " not implemented")
-	return staticType(name)
+	return "", false
 }
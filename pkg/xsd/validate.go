@@ -0,0 +1,394 @@
+package xsd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// ValidationError describes single violation of the schema found in XML instance document
+type ValidationError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// node is an element of instance document being validated
+type node struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*node
+	parent   *node
+	text     string
+	offset   int64
+}
+
+type validator struct {
+	ws          *Workspace
+	lineOffsets []int64
+	errors      []ValidationError
+	tables      []identityTable
+}
+
+// Validate checks XML instance document against schemas loaded in the workspace and reports
+// all problems found. Returned error indicates document could not be read at all.
+func (ws *Workspace) Validate(r io.Reader) ([]ValidationError, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	v := validator{ws: ws, lineOffsets: lineOffsets(data)}
+	root, err := v.parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	el := ws.globalElement(root.name)
+	if el == nil {
+		v.errorf(root, "no global xsd:element declaration found for %s", formatName(root.name))
+		return v.errors, nil
+	}
+	v.validateElement(root, el)
+	// Identity constraints are checked once their scope is complete, report in document order
+	sort.SliceStable(v.errors, func(i, j int) bool {
+		if v.errors[i].Line != v.errors[j].Line {
+			return v.errors[i].Line < v.errors[j].Line
+		}
+		return v.errors[i].Column < v.errors[j].Column
+	})
+	return v.errors, nil
+}
+
+func (v *validator) parse(data []byte) (*node, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var root *node
+	var stack []*node
+	for {
+		offset := d.InputOffset()
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name, attrs: t.Attr, offset: offset}
+			if len(stack) == 0 {
+				root = n
+			} else {
+				n.parent = stack[len(stack)-1]
+				n.parent.children = append(n.parent.children, n)
+			}
+			stack = append(stack, n)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("Document contains no root element")
+	}
+	return root, nil
+}
+
+func lineOffsets(data []byte) []int64 {
+	offsets := []int64{0}
+	for i, b := range data {
+		if b == '\n' {
+			offsets = append(offsets, int64(i+1))
+		}
+	}
+	return offsets
+}
+
+func (v *validator) errorf(n *node, format string, args ...interface{}) {
+	line := sort.Search(len(v.lineOffsets), func(i int) bool { return v.lineOffsets[i] > n.offset })
+	v.errors = append(v.errors, ValidationError{
+		Line:    line,
+		Column:  int(n.offset-v.lineOffsets[line-1]) + 1,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (ws *Workspace) globalElement(name xml.Name) *Element {
+	for _, sch := range ws.Schemas() {
+		if sch.TargetNamespace != name.Space {
+			continue
+		}
+		if el := sch.GetElement(name.Local); el != nil {
+			return el
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateElement(n *node, el *Element) {
+	if el.refElm != nil {
+		el = el.refElm
+	}
+	if isNil(n) {
+		if !el.Nillable {
+			v.errorf(n, "element %s is not nillable", n.name.Local)
+		} else if len(n.children) > 0 || strings.TrimSpace(n.text) != "" {
+			v.errorf(n, "element %s has xsi:nil set but is not empty", n.name.Local)
+		}
+		return
+	}
+
+	typ := el.typ
+	if len(el.Alternatives) > 0 {
+		typ = v.selectAlternative(n, el)
+	}
+	switch typ := typ.(type) {
+	case nil:
+		// No type given, that is xsd:anyType
+	case *ComplexType:
+		v.validateComplexContent(n, typ)
+		v.validateAssertions(n, typ)
+	default:
+		v.validateAttributes(n, nil, nil, el.schema)
+		if len(n.children) > 0 {
+			v.errorf(n, "element %s of simple type must not contain child elements", n.name.Local)
+			return
+		}
+		v.validateValue(n, typ, n.text, "element "+n.name.Local)
+	}
+	v.validateIdentityConstraints(n, el)
+}
+
+func (v *validator) validateComplexContent(n *node, ct *ComplexType) {
+	v.validateAttributes(n, ct.Attributes(), ct.anyAttribute(), ct.schema)
+
+	if textType := ct.simpleContentType(); textType != nil {
+		if len(n.children) > 0 {
+			v.errorf(n, "element %s has simple content and must not contain child elements", n.name.Local)
+			return
+		}
+		v.validateValue(n, textType, n.text, "element "+n.name.Local)
+		return
+	}
+
+	if !ct.Mixed && strings.TrimSpace(n.text) != "" {
+		v.errorf(n, "element %s must not contain text", n.name.Local)
+	}
+
+	model := ct.contentModel()
+	oc := ct.openContent()
+	children, open := n.children, []*node(nil)
+	if oc != nil && (!oc.suffix() || model == nil) {
+		children, open = oc.interleaved(n.children, model, ct.schema.TargetNamespace)
+	}
+	for _, child := range open {
+		v.validateWildcardChild(child, oc.Any)
+	}
+	if model == nil {
+		if len(children) > 0 {
+			v.errorf(children[0], "element %s must be empty, found child element %s", n.name.Local, children[0].name.Local)
+		}
+		return
+	}
+
+	m := newContentMatcher(children, ct.schema.TargetNamespace)
+	pos, ok := m.match(model, 0)
+	if ok && oc != nil && oc.suffix() && oc.allowsAll(children[pos:], ct.schema.TargetNamespace) {
+		for _, child := range children[pos:] {
+			v.validateWildcardChild(child, oc.Any)
+		}
+		children = children[:pos]
+	}
+	if !ok || pos < len(children) {
+		errPos := pos
+		if m.furthest > errPos {
+			errPos = m.furthest
+		}
+		expected := ""
+		if len(m.expected) > 0 {
+			expected = fmt.Sprintf(", expected: %s", strings.Join(m.expected, ", "))
+		}
+		if errPos < len(children) {
+			v.errorf(children[errPos], "unexpected element %s in %s%s", children[errPos].name.Local, n.name.Local, expected)
+		} else {
+			v.errorf(n, "content of element %s is incomplete%s", n.name.Local, expected)
+		}
+	}
+
+	for idx, child := range children {
+		p := m.assigned[idx]
+		switch {
+		case p == nil:
+			continue
+		case p.element != nil:
+			v.validateElement(child, p.element)
+		default:
+			v.validateWildcardChild(child, p.wildcard)
+		}
+	}
+}
+
+// validateWildcardChild validates element matched by xsd:any according to its processContents
+func (v *validator) validateWildcardChild(child *node, wildcard *Any) {
+	if wildcard.ProcessContents == "skip" {
+		return
+	}
+	el := v.ws.globalElement(child.name)
+	if el != nil {
+		v.validateElement(child, el)
+	} else if wildcard.ProcessContents != "lax" {
+		v.errorf(child, "no global xsd:element declaration found for %s", formatName(child.name))
+	}
+}
+
+func (v *validator) validateAttributes(n *node, declared []Attribute, wildcard *AnyAttribute, sch *Schema) {
+	seen := map[xml.Name]bool{}
+	for _, attr := range n.attrs {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") || attr.Name.Space == xsiNamespace {
+			continue
+		}
+		seen[attr.Name] = true
+		decl := findAttribute(declared, attr.Name)
+		if decl == nil {
+			targetNs := ""
+			if sch != nil {
+				targetNs = sch.TargetNamespace
+			}
+			if wildcard == nil || !wildcardAllows(wildcard.Namespace, targetNs, attr.Name.Space) {
+				v.errorf(n, "attribute %s is not allowed on element %s", formatName(attr.Name), n.name.Local)
+			}
+			continue
+		}
+		if decl.Use == "prohibited" {
+			v.errorf(n, "attribute %s is prohibited on element %s", formatName(attr.Name), n.name.Local)
+			continue
+		}
+		if typ := decl.resolvedType(); typ != nil {
+			v.validateValue(n, typ, attr.Value, "attribute "+attr.Name.Local)
+		}
+		if decl.Fixed != "" && attr.Value != decl.Fixed {
+			v.errorf(n, "attribute %s on element %s must have fixed value '%s'", formatName(attr.Name), n.name.Local, decl.Fixed)
+		}
+	}
+	for idx := range declared {
+		decl := &declared[idx]
+		if decl.Use == "required" && !seen[decl.qualifiedName()] {
+			v.errorf(n, "required attribute %s missing on element %s", decl.XmlName(), n.name.Local)
+		}
+	}
+}
+
+func findAttribute(attrs []Attribute, name xml.Name) *Attribute {
+	for idx := range attrs {
+		if attrs[idx].qualifiedName() == name {
+			return &attrs[idx]
+		}
+	}
+	return nil
+}
+
+func isNil(n *node) bool {
+	for _, attr := range n.attrs {
+		if attr.Name.Space == xsiNamespace && attr.Name.Local == "nil" {
+			return strings.TrimSpace(attr.Value) == "true" || strings.TrimSpace(attr.Value) == "1"
+		}
+	}
+	return false
+}
+
+func formatName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return fmt.Sprintf("{%s}%s", name.Space, name.Local)
+}
+
+// qualifiedName is the name of the element as it appears in instance documents
+func (e *Element) qualifiedName() xml.Name {
+	if e.refElm != nil {
+		return e.refElm.qualifiedName()
+	}
+	ns := ""
+	if e.Form == "qualified" || (e.Form == "" && e.schema.ElementFormDefault == "qualified") || e.schema.GetElement(e.Name) == e {
+		ns = e.schema.TargetNamespace
+	}
+	return xml.Name{Space: ns, Local: e.Name}
+}
+
+// qualifiedName is the name of the attribute as it appears in instance documents
+func (a *Attribute) qualifiedName() xml.Name {
+	if a.Ref != "" {
+		return xml.Name{Space: a.schema.NamespaceByPrefix(a.Ref.NsPrefix()), Local: a.Ref.Name()}
+	}
+	ns := ""
+	if a.Form == "qualified" || (a.Form == "" && a.schema != nil && a.schema.AttributeFormDefault == "qualified") {
+		ns = a.schema.TargetNamespace
+	}
+	return xml.Name{Space: ns, Local: a.Name}
+}
+
+// resolvedType returns simple type of the attribute or nil when it is xsd:anySimpleType
+func (a *Attribute) resolvedType() Type {
+	switch {
+	case a.refAttr != nil:
+		return a.refAttr.resolvedType()
+	case a.SimpleType != nil:
+		return a.SimpleType
+	case a.Type != "" && a.schema != nil:
+		return a.schema.LookupType(a.Type)
+	}
+	return nil
+}
+
+func (ct *ComplexType) anyAttribute() *AnyAttribute {
+	if ct.AnyAttribute != nil {
+		return ct.AnyAttribute
+	}
+	var ext *Extension
+	if ct.ComplexContent != nil {
+		ext = ct.ComplexContent.Extension
+		if r := ct.ComplexContent.Restriction; r != nil {
+			return r.AnyAttribute
+		}
+	} else if ct.SimpleContent != nil {
+		ext = ct.SimpleContent.Extension
+	}
+	if ext == nil {
+		return nil
+	}
+	if ext.AnyAttribute != nil {
+		return ext.AnyAttribute
+	}
+	if base, ok := ext.typ.(*ComplexType); ok && base != ct {
+		return base.anyAttribute()
+	}
+	return nil
+}
+
+// simpleContentType returns type of the character data of complex type with simple content
+func (ct *ComplexType) simpleContentType() Type {
+	if ct.SimpleContent == nil || ct.SimpleContent.Extension == nil {
+		return nil
+	}
+	switch base := ct.SimpleContent.Extension.typ.(type) {
+	case *ComplexType:
+		if base == ct {
+			return nil
+		}
+		return base.simpleContentType()
+	default:
+		return base
+	}
+}
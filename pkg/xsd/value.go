@@ -0,0 +1,380 @@
+package xsd
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	ncNameRegexp   = regexp.MustCompile(`^[\pL_][\pL\pN._\-\x{B7}\pM]*$`)
+	nameRegexp     = regexp.MustCompile(`^[\pL_:][\pL\pN._:\-\x{B7}\pM]*$`)
+	nmtokenRegexp  = regexp.MustCompile(`^[\pL\pN._:\-\x{B7}\pM]+$`)
+	languageRegexp = regexp.MustCompile(`^[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*$`)
+	integerRegexp  = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	decimalRegexp  = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	floatRegexp    = regexp.MustCompile(`^([+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?|-?INF|NaN)$`)
+	timezone       = `(Z|[+-]((0[0-9]|1[0-3]):[0-5][0-9]|14:00))?`
+	dateRegexp     = regexp.MustCompile(`^-?[0-9]{4,}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])` + timezone + `$`)
+	timeRegexp     = regexp.MustCompile(`^(([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]+)?|24:00:00(\.0+)?)` + timezone + `$`)
+	dateTimeRegexp = regexp.MustCompile(`^-?[0-9]{4,}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])T(([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]+)?|24:00:00(\.0+)?)` + timezone + `$`)
+	durationRegexp = regexp.MustCompile(`^-?P([0-9]+Y)?([0-9]+M)?([0-9]+D)?(T([0-9]+H)?([0-9]+M)?([0-9]+(\.[0-9]+)?S)?)?$`)
+	gRegexps       = map[string]*regexp.Regexp{
+		"gYear":      regexp.MustCompile(`^-?[0-9]{4,}` + timezone + `$`),
+		"gYearMonth": regexp.MustCompile(`^-?[0-9]{4,}-(0[1-9]|1[0-2])` + timezone + `$`),
+		"gMonth":     regexp.MustCompile(`^--(0[1-9]|1[0-2])` + timezone + `$`),
+		"gMonthDay":  regexp.MustCompile(`^--(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])` + timezone + `$`),
+		"gDay":       regexp.MustCompile(`^---(0[1-9]|[12][0-9]|3[01])` + timezone + `$`),
+	}
+	integerRanges = map[string][2]string{
+		"long":               {"-9223372036854775808", "9223372036854775807"},
+		"int":                {"-2147483648", "2147483647"},
+		"short":              {"-32768", "32767"},
+		"byte":               {"-128", "127"},
+		"unsignedLong":       {"0", "18446744073709551615"},
+		"unsignedInt":        {"0", "4294967295"},
+		"unsignedShort":      {"0", "65535"},
+		"unsignedByte":       {"0", "255"},
+		"nonNegativeInteger": {"0", ""},
+		"positiveInteger":    {"1", ""},
+		"nonPositiveInteger": {"", "0"},
+		"negativeInteger":    {"", "-1"},
+	}
+	patternCache     = map[string]*regexp.Regexp{}
+	patternCacheLock sync.Mutex
+)
+
+func (v *validator) validateValue(n *node, typ Type, value, what string) {
+	if err := checkValue(typ, value); err != nil {
+		v.errorf(n, "%s: %s", what, err)
+	}
+}
+
+// checkValue reports whether value belongs to the value space of given simple type
+func checkValue(typ Type, value string) error {
+	switch t := typ.(type) {
+	case staticType:
+		return checkBuiltin(string(t), value)
+	case *SimpleType:
+		return t.check(value)
+	case *ComplexType:
+		if textType := t.simpleContentType(); textType != nil {
+			return checkValue(textType, value)
+		}
+	}
+	return nil
+}
+
+func (st *SimpleType) check(value string) error {
+	switch {
+	case st.Restriction != nil:
+		return st.Restriction.check(st.schema, value)
+	case st.List != nil:
+		itemType := st.List.itemType(st.schema)
+		for _, item := range strings.Fields(value) {
+			if err := checkValue(itemType, item); err != nil {
+				return err
+			}
+		}
+	case st.Union != nil:
+		members := st.Union.memberTypes(st.schema)
+		if len(members) == 0 {
+			return nil
+		}
+		for _, member := range members {
+			if checkValue(member, value) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("value '%s' does not match any member of the union", value)
+	}
+	return nil
+}
+
+func (l *List) itemType(sch *Schema) Type {
+	if l.SimpleType != nil {
+		return l.SimpleType
+	}
+	return lookupSimpleType(sch, l.ItemType)
+}
+
+func (u *Union) memberTypes(sch *Schema) []Type {
+	var res []Type
+	for _, name := range strings.Fields(u.MemberTypes) {
+		if typ := lookupSimpleType(sch, name); typ != nil {
+			res = append(res, typ)
+		}
+	}
+	for idx := range u.SimpleTypes {
+		res = append(res, &u.SimpleTypes[idx])
+	}
+	return res
+}
+
+func lookupSimpleType(sch *Schema, name string) Type {
+	if sch == nil || name == "" {
+		return nil
+	}
+	return sch.LookupType(name)
+}
+
+func (r *Restriction) baseType(sch *Schema) Type {
+	if r.SimpleType != nil {
+		return r.SimpleType
+	}
+	return lookupSimpleType(sch, r.Base)
+}
+
+func (r *Restriction) check(sch *Schema, value string) error {
+	base := r.baseType(sch)
+	if base != nil {
+		if err := checkValue(base, value); err != nil {
+			return err
+		}
+	}
+	if !preservesWhitespace(base) {
+		value = collapseWhitespace(value)
+	}
+
+	if len(r.Enumerations) > 0 {
+		found := false
+		for _, enum := range r.Enumerations {
+			if enum.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("value '%s' is not one of the enumerated values", value)
+		}
+	}
+	if len(r.Patterns) > 0 {
+		// Multiple patterns within single restriction are alternatives
+		matched := false
+		for _, pattern := range r.Patterns {
+			re := compilePattern(pattern.Value)
+			if re == nil || re.MatchString(value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("value '%s' does not match pattern '%s'", value, r.Patterns[0].Value)
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if isListType(base) {
+		length = len(strings.Fields(value))
+	}
+	if r.Length != nil && length != atoi(r.Length.Value) {
+		return fmt.Errorf("value '%s' does not have length %s", value, r.Length.Value)
+	}
+	if r.MinLength != nil && length < atoi(r.MinLength.Value) {
+		return fmt.Errorf("value '%s' is shorter than %s", value, r.MinLength.Value)
+	}
+	if r.MaxLength != nil && length > atoi(r.MaxLength.Value) {
+		return fmt.Errorf("value '%s' is longer than %s", value, r.MaxLength.Value)
+	}
+
+	if r.MinInclusive != nil && compareValues(value, r.MinInclusive.Value) < 0 {
+		return fmt.Errorf("value '%s' is less than %s", value, r.MinInclusive.Value)
+	}
+	if r.MaxInclusive != nil && compareValues(value, r.MaxInclusive.Value) > 0 {
+		return fmt.Errorf("value '%s' is greater than %s", value, r.MaxInclusive.Value)
+	}
+	if r.MinExclusive != nil && compareValues(value, r.MinExclusive.Value) <= 0 {
+		return fmt.Errorf("value '%s' must be greater than %s", value, r.MinExclusive.Value)
+	}
+	if r.MaxExclusive != nil && compareValues(value, r.MaxExclusive.Value) >= 0 {
+		return fmt.Errorf("value '%s' must be less than %s", value, r.MaxExclusive.Value)
+	}
+
+	if r.TotalDigits != nil || r.FractionDigits != nil {
+		total, fraction := countDigits(value)
+		if r.TotalDigits != nil && total > atoi(r.TotalDigits.Value) {
+			return fmt.Errorf("value '%s' has more than %s digits", value, r.TotalDigits.Value)
+		}
+		if r.FractionDigits != nil && fraction > atoi(r.FractionDigits.Value) {
+			return fmt.Errorf("value '%s' has more than %s fraction digits", value, r.FractionDigits.Value)
+		}
+	}
+	return r.checkAssertionFacets(sch, value)
+}
+
+func checkBuiltin(name, value string) error {
+	if name != "string" && name != "normalizedString" && name != "anySimpleType" {
+		value = collapseWhitespace(value)
+	}
+	valid := true
+	switch name {
+	case "boolean":
+		valid = value == "true" || value == "false" || value == "1" || value == "0"
+	case "integer", "long", "int", "short", "byte", "unsignedLong", "unsignedInt", "unsignedShort", "unsignedByte",
+		"nonNegativeInteger", "positiveInteger", "nonPositiveInteger", "negativeInteger":
+		valid = integerRegexp.MatchString(value) && inIntegerRange(name, value)
+	case "decimal":
+		valid = decimalRegexp.MatchString(value)
+	case "float", "double":
+		valid = floatRegexp.MatchString(value)
+	case "date":
+		valid = dateRegexp.MatchString(value)
+	case "time":
+		valid = timeRegexp.MatchString(value)
+	case "dateTime":
+		valid = dateTimeRegexp.MatchString(value)
+	case "duration":
+		valid = durationRegexp.MatchString(value) && value != "P" && value != "-P" && !strings.HasSuffix(value, "T")
+	case "gYear", "gYearMonth", "gMonth", "gMonthDay", "gDay":
+		valid = gRegexps[name].MatchString(value)
+	case "NCName", "ID", "IDREF":
+		valid = ncNameRegexp.MatchString(value)
+	case "IDREFS":
+		valid = value != ""
+		for _, item := range strings.Fields(value) {
+			valid = valid && ncNameRegexp.MatchString(item)
+		}
+	case "Name":
+		valid = nameRegexp.MatchString(value)
+	case "NMTOKEN":
+		valid = nmtokenRegexp.MatchString(value)
+	case "NMTOKENS":
+		valid = value != ""
+		for _, item := range strings.Fields(value) {
+			valid = valid && nmtokenRegexp.MatchString(item)
+		}
+	case "QName":
+		parts := strings.Split(value, ":")
+		valid = len(parts) <= 2
+		for _, part := range parts {
+			valid = valid && ncNameRegexp.MatchString(part)
+		}
+	case "language":
+		valid = languageRegexp.MatchString(value)
+	case "base64Binary":
+		_, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+		valid = err == nil
+	case "hexBinary":
+		_, err := hex.DecodeString(value)
+		valid = err == nil
+	}
+	if !valid {
+		return fmt.Errorf("value '%s' is not a valid xsd:%s", value, name)
+	}
+	return nil
+}
+
+func inIntegerRange(name, value string) bool {
+	bounds, found := integerRanges[name]
+	if !found {
+		return true
+	}
+	n, ok := new(big.Int).SetString(strings.TrimPrefix(value, "+"), 10)
+	if !ok {
+		return false
+	}
+	if bounds[0] != "" {
+		min, _ := new(big.Int).SetString(bounds[0], 10)
+		if n.Cmp(min) < 0 {
+			return false
+		}
+	}
+	if bounds[1] != "" {
+		max, _ := new(big.Int).SetString(bounds[1], 10)
+		if n.Cmp(max) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// compareValues compares numbers numerically, other values (such as dates of the same
+// format) lexicographically
+func compareValues(a, b string) int {
+	x, okX := new(big.Float).SetString(strings.TrimPrefix(a, "+"))
+	y, okY := new(big.Float).SetString(strings.TrimPrefix(b, "+"))
+	if okX && okY {
+		return x.Cmp(y)
+	}
+	return strings.Compare(a, b)
+}
+
+func countDigits(value string) (total, fraction int) {
+	value = strings.TrimLeft(value, "+-")
+	intPart, fracPart := value, ""
+	if i := strings.Index(value, "."); i >= 0 {
+		intPart, fracPart = value[:i], value[i+1:]
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+	return len(intPart) + len(fracPart), len(fracPart)
+}
+
+// compilePattern translates XSD regular expression to go regexp. Returns nil when the
+// pattern uses constructs go does not support, such patterns are not enforced.
+func compilePattern(pattern string) *regexp.Regexp {
+	patternCacheLock.Lock()
+	defer patternCacheLock.Unlock()
+	if re, found := patternCache[pattern]; found {
+		return re
+	}
+	translated := strings.NewReplacer(
+		`\i`, `[\pL_:]`,
+		`\I`, `[^\pL_:]`,
+		`\c`, `[\pL\pN._:\-\x{B7}]`,
+		`\C`, `[^\pL\pN._:\-\x{B7}]`,
+	).Replace(pattern)
+	re, err := regexp.Compile(`^(?:` + translated + `)$`)
+	if err != nil {
+		re = nil
+	}
+	patternCache[pattern] = re
+	return re
+}
+
+func preservesWhitespace(typ Type) bool {
+	switch t := typ.(type) {
+	case nil:
+		return true
+	case staticType:
+		return t == "string" || t == "normalizedString" || t == "anySimpleType"
+	case *SimpleType:
+		if t.Restriction != nil {
+			if t.Restriction.WhiteSpace != nil {
+				return t.Restriction.WhiteSpace.Value != "collapse"
+			}
+			return preservesWhitespace(t.Restriction.baseType(t.schema))
+		}
+	}
+	return false
+}
+
+func isListType(typ Type) bool {
+	switch t := typ.(type) {
+	case staticType:
+		return t == "IDREFS" || t == "NMTOKENS"
+	case *SimpleType:
+		if t.List != nil {
+			return true
+		}
+		if t.Restriction != nil {
+			return isListType(t.Restriction.baseType(t.schema))
+		}
+	}
+	return false
+}
+
+func collapseWhitespace(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func atoi(value string) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(value))
+	return n
+}
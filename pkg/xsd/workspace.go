@@ -1,53 +1,366 @@
 package xsd
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 )
 
 type Workspace struct {
+	// Cache maps canonical paths (see cacheKey) and URLs to loaded schemas
 	Cache         map[string]*Schema
 	GoModulesPath string
+	loadOrder     []*Schema
+	// pending schemas were loaded but not compiled yet, imports precede importing schemas
+	pending    []*Schema
+	fsys       fs.FS
+	logger     Logger
+	catalog    *Catalog
+	resolver   Resolver
+	schemaDirs []string
+	xsdVersion string
+	// namespaceIndex maps namespaces to schemas found in schemaDirs, see schemaDirIndex
+	namespaceIndex map[string]string
+	// origins maps paths of schemas mapped by the catalog to the URL they were imported as
+	origins map[string]string
 }
 
+// WorkspaceOptions controls how schemas are loaded, see NewWorkspaceWithOptions
+type WorkspaceOptions struct {
+	// FS the schemas are read from using slash separated paths, nil stands for the OS filesystem
+	FS fs.FS
+	// GoModulesPath is the import path prefix of generated go packages
+	GoModulesPath string
+	// Logger receives progress messages, nil discards them
+	Logger Logger
+	// Catalog maps schema locations and namespaces of imports to local files
+	Catalog *Catalog
+	// Resolver fetches http(s) schema locations not mapped by the Catalog, nil disables fetching
+	Resolver Resolver
+	// SchemaDirs are scanned for *.xsd files declaring namespaces imported without schemaLocation
+	SchemaDirs []string
+	// XSDVersion is XSDVersion11 (default) or XSDVersion10, which rejects XSD 1.1 constructs
+	XSDVersion string
+}
+
+// Resolver fetches remote schemas
+type Resolver interface {
+	Fetch(url string) ([]byte, error)
+}
+
+// NewWorkspace loads xsdPath and its imports from the OS filesystem without reporting progress
 func NewWorkspace(goModulesPath, xsdPath string) (*Workspace, error) {
+	return NewWorkspaceFS(nil, goModulesPath, xsdPath, nil)
+}
+
+// NewWorkspaceFS loads xsdPath and its imports from fsys, using slash separated paths as
+// fs.FS requires. Nil fsys stands for the OS filesystem and nil logger discards messages.
+func NewWorkspaceFS(fsys fs.FS, goModulesPath, xsdPath string, logger Logger) (*Workspace, error) {
+	return NewWorkspaceWithOptions(WorkspaceOptions{FS: fsys, GoModulesPath: goModulesPath, Logger: logger}, xsdPath)
+}
+
+// NewWorkspaceWithOptions loads one or more root schemas with their imports into a single
+// workspace, schemas shared by several roots are loaded once
+func NewWorkspaceWithOptions(opts WorkspaceOptions, xsdPaths ...string) (*Workspace, error) {
+	logger := OrDiscard(opts.Logger)
+	switch opts.XSDVersion {
+	case "":
+		opts.XSDVersion = XSDVersion11
+	case XSDVersion10, XSDVersion11:
+	default:
+		return nil, fmt.Errorf("Unknown XSD version '%s', expected %s or %s", opts.XSDVersion, XSDVersion10, XSDVersion11)
+	}
 	ws := Workspace{
 		Cache:         map[string]*Schema{},
-		GoModulesPath: goModulesPath,
+		GoModulesPath: opts.GoModulesPath,
+		fsys:          opts.FS,
+		logger:        logger,
+		catalog:       opts.Catalog,
+		resolver:      opts.Resolver,
+		schemaDirs:    opts.SchemaDirs,
+		xsdVersion:    opts.XSDVersion,
+		origins:       map[string]string{},
+	}
+	for _, xsdPath := range xsdPaths {
+		if _, err := ws.Load(xsdPath); err != nil {
+			return &ws, err
+		}
 	}
+	return &ws, nil
+}
+
+// Load adds another root schema with its imports to the workspace. The whole import graph
+// is loaded first and only then are the newly loaded schemas compiled, so that mutually
+// importing schemas see each other's declarations. Schemas left uncompiled by compilation
+// error are dropped from the workspace.
+func (ws *Workspace) Load(xsdPath string) (*Schema, error) {
 	var err error
-	_, err = ws.loadXsd(xsdPath)
-	return &ws, err
+	if isURL(xsdPath) {
+		if xsdPath, err = ws.locate("", "", xsdPath); err != nil {
+			return nil, err
+		}
+	}
+	schema, err := ws.loadXsd(xsdPath)
+	if err != nil {
+		return nil, err
+	}
+	return schema, ws.compilePending()
+}
+
+// Source is schema document held in memory, such as schema embedded in WSDL
+type Source struct {
+	// Path names the schema in messages, relative imports are resolved against it
+	Path string
+	Data []byte
 }
 
+// LoadSources adds schemas held in memory with their imports to the workspace. The sources
+// are compiled together, so they may import each other by namespace only.
+func (ws *Workspace) LoadSources(sources ...Source) ([]*Schema, error) {
+	var schemas []*Schema
+	for _, src := range sources {
+		xsdPath := ws.displayPath(src.Path)
+		key := ws.cacheKey(xsdPath)
+		schema, found := ws.Cache[key]
+		if !found {
+			ws.logger.Infof("\tParsing: %s", xsdPath)
+			var err error
+			if schema, err = ws.parseSource(xsdPath, bytes.NewReader(src.Data)); err != nil {
+				return nil, err
+			}
+			if err := ws.addSchema(schema, key); err != nil {
+				return nil, err
+			}
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, ws.compilePending()
+}
+
+// compilePending compiles schemas loaded since the last compilation
+func (ws *Workspace) compilePending() error {
+	ws.resolveDeferredImports()
+	pending := ws.pending
+	ws.pending = nil
+	for idx, sch := range pending {
+		if err := sch.compileChecked(); err != nil {
+			// Later loads parse the failed schema and those not compiled yet again
+			ws.forget(pending[idx:])
+			return err
+		}
+	}
+	return nil
+}
+
+// forget removes schemas from the cache and the load order
+func (ws *Workspace) forget(schemas []*Schema) {
+	forgotten := map[*Schema]bool{}
+	for _, sch := range schemas {
+		forgotten[sch] = true
+	}
+	for key, sch := range ws.Cache {
+		if forgotten[sch] {
+			delete(ws.Cache, key)
+		}
+	}
+	var loadOrder []*Schema
+	for _, sch := range ws.loadOrder {
+		if !forgotten[sch] {
+			loadOrder = append(loadOrder, sch)
+		}
+	}
+	ws.loadOrder = loadOrder
+}
+
+// Schema returns already loaded schema of given path, nil if there is none
+func (ws *Workspace) Schema(xsdPath string) *Schema {
+	return ws.Cache[ws.cacheKey(xsdPath)]
+}
+
+// loadXsd parses schema and recursively its imports. The schema is cached before its imports
+// are loaded, so that import cycles terminate, and queued for compilation after its imports.
 func (ws *Workspace) loadXsd(xsdPath string) (*Schema, error) {
-	cached, found := ws.Cache[xsdPath]
+	xsdPath = ws.displayPath(xsdPath)
+	key := ws.cacheKey(xsdPath)
+	cached, found := ws.Cache[key]
 	if found {
+		ws.logger.Verbosef("\tReusing already parsed %s", cached.filePath)
 		return cached, nil
 	}
-	fmt.Println("\tParsing:", xsdPath)
+	schema, err := ws.parseXsd(xsdPath)
+	if err != nil {
+		return nil, err
+	}
+	return schema, ws.addSchema(schema, key)
+}
+
+// addSchema caches parsed schema, loads its imports and queues it for compilation
+func (ws *Workspace) addSchema(schema *Schema, key string) error {
+	ws.Cache[key] = schema
+	ws.loadOrder = append(ws.loadOrder, schema)
+
+	// Imports of redefined schemas are merged in and loaded already
+	imports := len(schema.Imports)
+	if err := ws.applyRedefines(schema); err != nil {
+		return err
+	}
+	for idx := 0; idx < imports; idx++ {
+		if err := schema.Imports[idx].load(ws, schema.filePath); err != nil {
+			return err
+		}
+	}
+	ws.pending = append(ws.pending, schema)
+	return nil
+}
 
-	f, err := os.Open(xsdPath)
+func (ws *Workspace) parseXsd(xsdPath string) (*Schema, error) {
+	ws.logger.Infof("\tParsing: %s", xsdPath)
+
+	f, err := ws.open(xsdPath)
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
+	return ws.parseSource(xsdPath, f)
+}
 
-	schema, err := parseSchema(f)
+func (ws *Workspace) parseSource(xsdPath string, r io.Reader) (*Schema, error) {
+	schema, err := parseSchema(r)
 	if err != nil {
 		return nil, err
 	}
 	schema.ModulesPath = ws.GoModulesPath
 	schema.filePath = xsdPath
-	ws.Cache[xsdPath] = schema
+	schema.logger = ws.logger
+	schema.xsdVersion = ws.xsdVersion
+	return schema, nil
+}
+
+// resolveDeferredImports retries imports without schemaLocation that did not match any schema
+// when encountered, now that the whole import graph has been loaded
+func (ws *Workspace) resolveDeferredImports() {
+	for _, sch := range ws.pending {
+		for idx := range sch.Imports {
+			imp := &sch.Imports[idx]
+			if imp.ImportedSchema != nil || imp.SchemaLocation != "" {
+				continue
+			}
+			if loaded := ws.schemaByNamespace(imp.Namespace); loaded != nil {
+				ws.logger.Verbosef("\tImport of namespace '%s' in %s satisfied by %s loaded later", imp.Namespace, sch.filePath, loaded.filePath)
+				imp.ImportedSchema = loaded
+			}
+		}
+	}
+}
 
-	dir := filepath.Dir(xsdPath)
-	for idx, _ := range schema.Imports {
-		if err := schema.Imports[idx].load(ws, dir); err != nil {
+func (ws *Workspace) open(xsdPath string) (io.ReadCloser, error) {
+	if isURL(xsdPath) {
+		data, err := ws.resolver.Fetch(xsdPath)
+		if err != nil {
 			return nil, err
 		}
+		return io.NopCloser(bytes.NewReader(data)), nil
 	}
-	schema.compile()
-	return schema, nil
+	if ws.fsys != nil {
+		return ws.fsys.Open(xsdPath)
+	}
+	return os.Open(xsdPath)
+}
+
+// locate finds file imported by the referencing schema. XML catalog is consulted first for
+// the schema location, resolved against URL the referencing schema was imported as if any,
+// or for the namespace when there is no location. Remaining URLs are left to the Resolver.
+// Returns empty string when nothing is to be loaded.
+func (ws *Workspace) locate(referencingPath, namespace, location string) (string, error) {
+	if location == "" {
+		if target, found := ws.catalog.Resolve(namespace); found {
+			ws.logger.Verbosef("\tXML catalog maps namespace '%s' to %s", namespace, target)
+			return target, nil
+		}
+		index, err := ws.schemaDirIndex()
+		if err != nil {
+			return "", err
+		}
+		if target, found := index[namespace]; found {
+			ws.logger.Verbosef("\tNamespace '%s' found in schema directory as %s", namespace, target)
+			return target, nil
+		}
+		return "", nil
+	}
+	uri := location
+	origin, found := ws.origins[referencingPath]
+	if isURL(referencingPath) {
+		origin, found = referencingPath, true
+	}
+	if found && !isURL(location) {
+		if base, err := url.Parse(origin); err == nil {
+			if ref, err := url.Parse(location); err == nil {
+				uri = base.ResolveReference(ref).String()
+			}
+		}
+	}
+	if target, found := ws.catalog.Resolve(uri); found {
+		ws.logger.Verbosef("\tXML catalog maps %s to %s", uri, target)
+		if isURL(uri) {
+			ws.origins[target] = uri
+		}
+		return target, nil
+	}
+	if isURL(uri) {
+		if ws.resolver == nil {
+			importedBy := ""
+			if referencingPath != "" {
+				importedBy = " imported by " + referencingPath
+			}
+			return "", fmt.Errorf("Cannot load %s%s: no XML catalog entry matches and fetching of remote schemas is disabled", uri, importedBy)
+		}
+		return uri, nil
+	}
+	return ws.resolvePath(referencingPath, location), nil
+}
+
+// displayPath cleans path used in messages and to resolve relative imports
+func (ws *Workspace) displayPath(xsdPath string) string {
+	if isURL(xsdPath) {
+		return xsdPath
+	}
+	if ws.fsys != nil {
+		return path.Clean(xsdPath)
+	}
+	return filepath.Clean(xsdPath)
+}
+
+// cacheKey canonicalizes path, so that the same file reached by different relative paths or
+// through symbolic links is loaded once. Paths within fs.FS are only cleaned.
+func (ws *Workspace) cacheKey(xsdPath string) string {
+	xsdPath = ws.displayPath(xsdPath)
+	if isURL(xsdPath) || ws.fsys != nil {
+		return xsdPath
+	}
+	if abs, err := filepath.Abs(xsdPath); err == nil {
+		xsdPath = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(xsdPath); err == nil {
+		xsdPath = resolved
+	}
+	return xsdPath
+}
+
+// resolvePath resolves location relative to the directory of the referencing schema
+func (ws *Workspace) resolvePath(referencingPath, location string) string {
+	if ws.fsys != nil {
+		return path.Join(path.Dir(referencingPath), location)
+	}
+	return filepath.Join(filepath.Dir(referencingPath), location)
+}
+
+// Schemas lists all loaded schemas in the order they were loaded, the root schema comes first
+// followed by its imports depth first
+func (ws *Workspace) Schemas() []*Schema {
+	return append([]*Schema{}, ws.loadOrder...)
 }
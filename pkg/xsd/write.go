@@ -0,0 +1,130 @@
+package xsd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// Write serializes schema as XSD document. XMLSchema components are written with
+// "xsd" prefix and xmlns declarations of the schema are placed on the root element.
+func (sch *Schema) Write(w io.Writer) error {
+	raw, err := xml.Marshal(sch)
+	if err != nil {
+		return err
+	}
+
+	// encoding/xml repeats xmlns="..." on every element and never self-closes empty
+	// elements, re-encode the token stream to produce conventional XSD document.
+	enc := schemaEncoder{}
+	enc.buf.WriteString(xml.Header)
+	d := xml.NewDecoder(bytes.NewReader(raw))
+	root := true
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			start := xml.StartElement{Name: prefixedName("xsd", t.Name.Local)}
+			if root {
+				start.Attr = append(start.Attr, xml.Attr{Name: prefixedName("xmlns", "xsd"), Value: xsdNamespace})
+				for _, ns := range sch.Xmlns {
+					if ns.Prefix != "xsd" {
+						start.Attr = append(start.Attr, xml.Attr{Name: prefixedName("xmlns", ns.Prefix), Value: ns.Uri})
+					}
+				}
+				root = false
+			}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+				start.Attr = append(start.Attr, xml.Attr{Name: prefixedName(attr.Name.Space, attr.Name.Local), Value: attr.Value})
+			}
+			enc.start(start)
+		case xml.EndElement:
+			enc.end(prefixedName("xsd", t.Name.Local))
+		case xml.CharData:
+			if len(strings.TrimSpace(string(t))) > 0 {
+				enc.text(t)
+			}
+		}
+	}
+	enc.buf.WriteString("\n")
+	_, err = w.Write(enc.buf.Bytes())
+	return err
+}
+
+// schemaEncoder writes indented XML, elements without content are written self-closed
+type schemaEncoder struct {
+	buf bytes.Buffer
+	// pending start tag is written once it is known whether the element has content
+	pending *xml.StartElement
+	// nested tells for each open element whether it contains child elements
+	nested []bool
+}
+
+func (e *schemaEncoder) start(el xml.StartElement) {
+	e.flush(">")
+	if len(e.nested) > 0 {
+		e.nested[len(e.nested)-1] = true
+		e.newline()
+	}
+	e.pending = &el
+}
+
+func (e *schemaEncoder) end(name xml.Name) {
+	if e.pending != nil {
+		e.flush("/>")
+		return
+	}
+	nested := e.nested[len(e.nested)-1]
+	e.nested = e.nested[:len(e.nested)-1]
+	if nested {
+		e.newline()
+	}
+	e.buf.WriteString("</" + name.Local + ">")
+}
+
+func (e *schemaEncoder) text(data xml.CharData) {
+	e.flush(">")
+	xml.EscapeText(&e.buf, data)
+}
+
+// flush writes pending start tag closed with given suffix, elements closed by ">" are opened
+func (e *schemaEncoder) flush(suffix string) {
+	if e.pending == nil {
+		return
+	}
+	e.buf.WriteString("<" + e.pending.Name.Local)
+	for _, attr := range e.pending.Attr {
+		e.buf.WriteString(" " + attr.Name.Local + `="`)
+		xml.EscapeText(&e.buf, []byte(attr.Value))
+		e.buf.WriteString(`"`)
+	}
+	e.buf.WriteString(suffix)
+	e.pending = nil
+	if suffix == ">" {
+		e.nested = append(e.nested, false)
+	}
+}
+
+func (e *schemaEncoder) newline() {
+	e.buf.WriteString("\n" + strings.Repeat("  ", len(e.nested)))
+}
+
+// prefixedName creates name that encoding/xml writes verbatim
+func prefixedName(prefix, local string) xml.Name {
+	if prefix == "" {
+		return xml.Name{Local: local}
+	}
+	return xml.Name{Local: prefix + ":" + local}
+}
@@ -0,0 +1,242 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// XSD versions schemas are processed as, see WorkspaceOptions.XSDVersion
+const (
+	XSDVersion10 = "1.0"
+	XSDVersion11 = "1.1"
+)
+
+// Assert is XSD 1.1 xsd:assert of complex type or xsd:assertion facet of simple type. Test
+// is XPath 2.0 expression evaluated with the element as context item, $value is bound to
+// value of simple content or of the simple type.
+type Assert struct {
+	Test                  string      `xml:"test,attr"`
+	XPathDefaultNamespace string      `xml:"xpathDefaultNamespace,attr,omitempty"`
+	Annotation            *Annotation `xml:"annotation"`
+}
+
+// Alternative is XSD 1.1 xsd:alternative selecting type of an element by XPath test of its
+// attributes. Alternative without test is the default one.
+type Alternative struct {
+	Test        string       `xml:"test,attr,omitempty"`
+	Type        Reference    `xml:"type,attr,omitempty"`
+	ComplexType *ComplexType `xml:"complexType"`
+	SimpleType  *SimpleType  `xml:"simpleType"`
+	typ         Type
+}
+
+// GoTypeName is the go type of the alternative, empty for anonymous complex types
+func (a *Alternative) GoTypeName() string {
+	if a.typ == nil {
+		return ""
+	}
+	return a.typ.GoTypeName()
+}
+
+func (a *Alternative) compile(sch *Schema, el *Element) {
+	switch {
+	case a.ComplexType != nil:
+		a.typ = a.ComplexType
+		a.ComplexType.compile(sch, el)
+	case a.SimpleType != nil:
+		a.typ = a.SimpleType
+		a.SimpleType.compile(sch, el)
+	case a.Type != "":
+		a.typ = sch.findReferencedType(a.Type)
+		if a.typ == nil {
+			panic("Cannot resolve type reference of xsd:alternative: " + string(a.Type))
+		}
+	}
+}
+
+// OpenContent is XSD 1.1 xsd:openContent or xsd:defaultOpenContent, wildcard allowing
+// elements not declared by the content model among (interleave) or after (suffix) them
+type OpenContent struct {
+	Mode           string `xml:"mode,attr,omitempty"`
+	AppliesToEmpty bool   `xml:"appliesToEmpty,attr,omitempty"`
+	Any            *Any   `xml:"any"`
+}
+
+func (oc *OpenContent) suffix() bool {
+	return oc.Mode == "suffix"
+}
+
+func (oc *OpenContent) allowsAll(children []*node, targetNs string) bool {
+	for _, child := range children {
+		if !wildcardAllows(oc.Any.Namespace, targetNs, child.name.Space) {
+			return false
+		}
+	}
+	return true
+}
+
+// interleaved splits children to those matched by the content model and those accepted by
+// interleaved open content: elements the model does not declare, allowed by the wildcard
+func (oc *OpenContent) interleaved(children []*node, model *particle, targetNs string) ([]*node, []*node) {
+	declared := map[xml.Name]bool{}
+	model.declaredNames(declared)
+	var matched, open []*node
+	for _, child := range children {
+		if !declared[child.name] && wildcardAllows(oc.Any.Namespace, targetNs, child.name.Space) {
+			open = append(open, child)
+		} else {
+			matched = append(matched, child)
+		}
+	}
+	return matched, open
+}
+
+// declaredNames collects names of elements declared by the particle tree
+func (p *particle) declaredNames(names map[xml.Name]bool) {
+	if p == nil {
+		return
+	}
+	if p.element != nil {
+		names[p.element.qualifiedName()] = true
+	}
+	for _, child := range p.children {
+		child.declaredNames(names)
+	}
+}
+
+// openContent returns open content of the complex type: its own or the schema default,
+// nil when there is none
+func (ct *ComplexType) openContent() *OpenContent {
+	oc := ct.OpenContent
+	if oc == nil && ct.schema != nil && ct.schema.DefaultOpenContent != nil {
+		oc = ct.schema.DefaultOpenContent
+		if !oc.AppliesToEmpty && ct.contentModel() == nil {
+			return nil
+		}
+	}
+	if oc == nil || oc.Mode == "none" || oc.Any == nil {
+		return nil
+	}
+	return oc
+}
+
+// HasOpenContent tells whether the go struct of the complex type keeps undeclared elements
+// accepted by xsd:openContent. Mixed types keep them within their inner xml.
+func (ct *ComplexType) HasOpenContent() bool {
+	return ct.openContent() != nil && ct.simpleContentType() == nil && !ct.ContainsInnerXml()
+}
+
+// HasOpenContent tells whether the go struct of the element keeps undeclared elements
+// accepted by xsd:openContent of its type
+func (e *Element) HasOpenContent() bool {
+	if e.refElm != nil {
+		return e.refElm.HasOpenContent()
+	}
+	ct, ok := e.typ.(*ComplexType)
+	return ok && ct.HasOpenContent()
+}
+
+// Assertions lists xsd:assert of the complex type including those inherited from base types
+func (ct *ComplexType) Assertions() []Assert {
+	res := append([]Assert{}, ct.Asserts...)
+	var base Type
+	if ct.ComplexContent != nil {
+		if ext := ct.ComplexContent.Extension; ext != nil {
+			res = append(res, ext.Asserts...)
+			base = ext.typ
+		} else if r := ct.ComplexContent.Restriction; r != nil {
+			res = append(res, r.Asserts...)
+			if ct.schema != nil {
+				base = ct.schema.LookupType(r.Base)
+			}
+		}
+	} else if ct.SimpleContent != nil && ct.SimpleContent.Extension != nil {
+		res = append(res, ct.SimpleContent.Extension.Asserts...)
+		base = ct.SimpleContent.Extension.typ
+	}
+	if baseType, ok := base.(*ComplexType); ok && baseType != ct {
+		res = append(baseType.Assertions(), res...)
+	}
+	return res
+}
+
+// Assertions lists xsd:assert the go struct of the element is to satisfy
+func (e *Element) Assertions() []Assert {
+	if e.refElm != nil {
+		return e.refElm.Assertions()
+	}
+	if ct, ok := e.typ.(*ComplexType); ok {
+		return ct.Assertions()
+	}
+	return nil
+}
+
+// DefaultAlternativeGoType is the go type of element with xsd:alternatives when no test
+// matches: that of the default alternative or the declared type
+func (e *Element) DefaultAlternativeGoType() string {
+	for idx := range e.Alternatives {
+		if e.Alternatives[idx].Test == "" {
+			return e.Alternatives[idx].GoTypeName()
+		}
+	}
+	if e.Type == "" {
+		// Anonymous type is generated as the element struct
+		return e.GoName()
+	}
+	if e.typ == nil {
+		return ""
+	}
+	return e.typ.GoTypeName()
+}
+
+// HasAssertions tells whether any generated struct has xsd:assert or xsd:alternative
+func (sch *Schema) HasAssertions() bool {
+	for _, el := range sch.ExportableElements() {
+		if len(el.Assertions()) > 0 || len(el.Alternatives) > 0 {
+			return true
+		}
+	}
+	for _, ct := range sch.ExportableComplexTypes() {
+		if len(ct.Assertions()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// xpathNamespaces maps xmlns prefixes declared by the schema to namespaces
+func (sch *Schema) xpathNamespaces() map[string]string {
+	res := map[string]string{}
+	for _, decl := range sch.Xmlns {
+		res[decl.Prefix] = decl.Uri
+	}
+	return res
+}
+
+// xpathDefaultNamespace resolves @xpathDefaultNamespace of xsd:assert
+func (sch *Schema) xpathDefaultNamespace(value string) string {
+	switch value {
+	case "##targetNamespace":
+		return sch.TargetNamespace
+	case "", "##local", "##defaultNamespace":
+		return ""
+	}
+	return value
+}
+
+// VersionError reports XSD 1.1 construct found in schema processed as XSD 1.0
+type VersionError struct {
+	Construct  string
+	SchemaPath string
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s used in %s requires XSD version 1.1", e.Construct, e.SchemaPath)
+}
+
+// requireXSD11 panics with VersionError when the schema is processed as XSD 1.0
+func (sch *Schema) requireXSD11(construct string) {
+	if sch.xsdVersion == XSDVersion10 {
+		panic(&VersionError{Construct: construct, SchemaPath: sch.filePath})
+	}
+}
@@ -0,0 +1,131 @@
+package xsd2go
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// generatedHeader starts every file produced by the built-in templates
+const generatedHeader = "// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT."
+
+// Check generates code in memory and compares it with the content of opts.OutputDir, without
+// writing anything (opts.Sink is ignored). Out of date, missing and no longer generated files
+// are reported to opts.Logger, unified diff of them is written to opts.Diff. Returns error when
+// anything differs.
+func Check(ctx context.Context, opts Options) error {
+	opts.Sink = nil
+	res, err := Generate(ctx, opts)
+	if err != nil {
+		return err
+	}
+	outputDir := opts.OutputDir
+	logger := opts.logger()
+
+	expected := res.Files
+	packages := map[string]bool{}
+	for path := range expected {
+		packages[path[:strings.LastIndex(path, "/")]] = true
+	}
+
+	actual, err := readGeneratedFiles(outputDir, packages, expected)
+	if err != nil {
+		return err
+	}
+
+	var paths []string
+	for path := range expected {
+		paths = append(paths, path)
+	}
+	for path := range actual {
+		if _, found := expected[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	stale := 0
+	for _, path := range paths {
+		want, generated := expected[path]
+		got, exists := actual[path]
+		if generated && exists && bytes.Equal(want, got) {
+			continue
+		}
+		stale++
+		goFile := fmt.Sprintf("%s/%s", outputDir, path)
+		switch {
+		case !exists:
+			logger.Infof("\tMissing '%s'", goFile)
+		case !generated:
+			logger.Infof("\tNo longer generated '%s'", goFile)
+		default:
+			logger.Infof("\tOut of date '%s'", goFile)
+		}
+		if opts.Diff != nil {
+			diff, err := unifiedDiff(goFile, got, want)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(opts.Diff, diff); err != nil {
+				return err
+			}
+		}
+	}
+	if stale != 0 {
+		return fmt.Errorf("%d generated file(s) in '%s' are out of date", stale, outputDir)
+	}
+	return nil
+}
+
+// readGeneratedFiles reads files of generated packages found on disk. Unexpected files not
+// carrying generated header are left out, as these are likely hand-written additions.
+func readGeneratedFiles(outputDir string, packages map[string]bool, expected map[string][]byte) (map[string][]byte, error) {
+	res := map[string][]byte{}
+	for pkg := range packages {
+		entries, err := ioutil.ReadDir(filepath.Join(outputDir, pkg))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			content, err := ioutil.ReadFile(filepath.Join(outputDir, pkg, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			path := pkg + "/" + entry.Name()
+			if _, found := expected[path]; found || bytes.HasPrefix(content, []byte(generatedHeader)) {
+				res[path] = content
+			}
+		}
+	}
+	return res, nil
+}
+
+func unifiedDiff(name string, a, b []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        diffLines(a),
+		B:        diffLines(b),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+}
+
+func diffLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	return difflib.SplitLines(string(content))
+}
@@ -0,0 +1,132 @@
+package xsd2go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// mergeImportCycles generates namespaces whose go packages would import each other into one go
+// package. Schemas may import each other freely, but go packages generated for them must not.
+// The shared package is named after the first package of the cycle in generation order and
+// each schema gets its own file, as with source layout.
+func mergeImportCycles(schemas []*xsd.Schema, logger xsd.Logger) error {
+	imports := map[string][]string{}
+	members := map[string][]*xsd.Schema{}
+	var packages []string
+	for _, sch := range schemas {
+		pkg := sch.GoPackageName()
+		if _, found := imports[pkg]; !found {
+			packages = append(packages, pkg)
+		}
+		imports[pkg] = append(imports[pkg], sch.GoImportedPackages()...)
+		members[pkg] = append(members[pkg], sch)
+	}
+
+	for _, cycle := range stronglyConnected(packages, imports) {
+		if len(cycle) < 2 {
+			continue
+		}
+		name := cycle[0]
+		var merged []*xsd.Schema
+		var nss []string
+		for _, pkg := range cycle {
+			for _, sch := range members[pkg] {
+				merged = append(merged, sch)
+				nss = append(nss, sch.TargetNamespace)
+			}
+		}
+		if err := checkSharedNames(merged, name); err != nil {
+			return err
+		}
+		logger.Infof("\tNamespaces '%s' refer to each other's types, generating them into go package %s", strings.Join(nss, "', '"), name)
+		for _, sch := range merged {
+			sch.ShareGoPackage(name)
+		}
+	}
+	return nil
+}
+
+// checkSharedNames reports go types and functions that schemas moved to the same go package would both declare
+func checkSharedNames(schemas []*xsd.Schema, pkg string) error {
+	declared := map[string]string{}
+	for _, sch := range schemas {
+		var names []string
+		elements := sch.ExportableElements()
+		for idx := range elements {
+			names = append(names, elements[idx].GoName())
+		}
+		complexTypes := sch.ExportableComplexTypes()
+		for idx := range complexTypes {
+			names = append(names, complexTypes[idx].GoName())
+		}
+		for _, se := range sch.StreamableElements() {
+			names = append(names, se.GoStreamName())
+		}
+		for _, name := range names {
+			if other, found := declared[name]; found && other != sch.TargetNamespace {
+				return fmt.Errorf("Namespaces '%s' and '%s' refer to each other's types, but cannot share go package %s as both declare %s",
+					other, sch.TargetNamespace, pkg, name)
+			}
+			declared[name] = sch.TargetNamespace
+		}
+	}
+	return nil
+}
+
+// stronglyConnected groups packages that import each other, directly or through other packages,
+// using Tarjan's algorithm. Packages of a group keep their order.
+func stronglyConnected(packages []string, imports map[string][]string) [][]string {
+	index := map[string]int{}
+	lowlink := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var groups [][]string
+	var visit func(pkg string)
+	visit = func(pkg string) {
+		index[pkg] = len(index)
+		lowlink[pkg] = index[pkg]
+		stack = append(stack, pkg)
+		onStack[pkg] = true
+		for _, imported := range imports[pkg] {
+			if _, visited := index[imported]; !visited {
+				visit(imported)
+				if lowlink[imported] < lowlink[pkg] {
+					lowlink[pkg] = lowlink[imported]
+				}
+			} else if onStack[imported] && index[imported] < lowlink[pkg] {
+				lowlink[pkg] = index[imported]
+			}
+		}
+		if lowlink[pkg] != index[pkg] {
+			return
+		}
+		var group []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			group = append(group, top)
+			if top == pkg {
+				break
+			}
+		}
+		groups = append(groups, group)
+	}
+	for _, pkg := range packages {
+		if _, visited := index[pkg]; !visited {
+			visit(pkg)
+		}
+	}
+
+	position := map[string]int{}
+	for idx, pkg := range packages {
+		position[pkg] = idx
+	}
+	for _, group := range groups {
+		sort.Slice(group, func(i, j int) bool { return position[group[i]] < position[group[j]] })
+	}
+	return groups
+}
@@ -0,0 +1,17 @@
+package xsd2go
+
+import (
+	"errors"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Fetch loads the schema with all its imports through opts.Resolver, so that remote
+// schemas end up in its cache. Code generation related options are ignored.
+func Fetch(opts Options) (*xsd.Workspace, error) {
+	if opts.Resolver == nil {
+		return nil, errors.New("Resolver is required to fetch remote schemas")
+	}
+	ws, _, err := loadWorkspace(opts)
+	return ws, err
+}
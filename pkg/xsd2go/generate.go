@@ -1,27 +1,243 @@
 package xsd2go
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/wsdl"
 	"github.com/gocomply/xsd2go/pkg/xsd"
 )
 
+// Options of programmatic code generation, see Generate
+type Options struct {
+	// FS the XSD files are read from, nil stands for the OS filesystem. Paths within
+	// fs.FS are slash separated and relative to its root.
+	FS fs.FS
+	// XSDPath is the root schema, its imports are resolved relative to it
+	XSDPath string
+	// XSDPaths are further root schemas loaded into the same workspace. Both XSDPath and
+	// XSDPaths may be glob patterns or directories standing for all *.xsd files within.
+	XSDPaths []string
+	// WSDLPath is WSDL 1.1 document, schemas embedded in it are loaded as further roots and
+	// SOAP client stubs are generated for its services
+	WSDLPath string
+	// DTDPath is document type definition converted to schema loaded as further root
+	DTDPath string
+	// RNGPath is RELAX NG grammar in XML or compact (.rnc) syntax converted to schema loaded
+	// as further root
+	RNGPath string
+	// GoModule is the import path of the go module generated code lives in
+	GoModule string
+	// OutputDir is the directory of generated packages relative to GoModule
+	OutputDir string
+	// Catalog maps schema locations and namespaces of imports to local files, see xsd.LoadCatalog
+	Catalog *xsd.Catalog
+	// SchemaDirs are scanned for schemas declaring namespaces imported without schemaLocation
+	SchemaDirs []string
+	// Resolver fetches http(s) schemas not mapped by the Catalog, nil disables fetching.
+	// See fetch.Cache.
+	Resolver xsd.Resolver
+	// XSDVersion schemas are processed as: xsd.XSDVersion11 (default) or xsd.XSDVersion10
+	XSDVersion string
+	// Template controls how go code is rendered
+	Template template.Options
+	// Sink receives generated files when set, once all of them are rendered without conflicts.
+	// The files are returned in Result.Files either way.
+	Sink Sink
+	// Logger receives progress messages, nil discards them. See xsd.NewLogger.
+	Logger xsd.Logger
+	// Diff receives unified diff of out of date files found by Check, nil leaves diffs out
+	Diff io.Writer
+}
+
+// Result of code generation
+type Result struct {
+	// Workspace with all compiled schemas
+	Workspace *xsd.Workspace
+	// Files maps slash separated path relative to OutputDir to file content
+	Files map[string][]byte
+	paths []string
+}
+
+// Paths lists generated files in order of generation
+func (r *Result) Paths() []string {
+	return append([]string{}, r.paths...)
+}
+
+// Sink stores generated files
+type Sink interface {
+	WriteFile(path string, content []byte) error
+}
+
+// DirSink writes generated files under given directory
+type DirSink string
+
+func (dir DirSink) WriteFile(path string, content []byte) error {
+	fullPath := filepath.Join(string(dir), filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(fullPath), os.FileMode(0722)); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fullPath, content, 0644)
+}
+
 func Convert(xsdPath, goModule, outputDir string) error {
-	fmt.Printf("Processing '%s'\n", xsdPath)
-	ws, err := xsd.NewWorkspace(fmt.Sprintf("%s/%s", goModule, outputDir), xsdPath)
+	return ConvertWithOptions(xsdPath, goModule, outputDir, template.Options{})
+}
+
+func ConvertWithOptions(xsdPath, goModule, outputDir string, opts template.Options) error {
+	_, err := Generate(context.Background(), Options{
+		XSDPath:   xsdPath,
+		GoModule:  goModule,
+		OutputDir: outputDir,
+		Template:  opts,
+		Sink:      DirSink(outputDir),
+	})
+	return err
+}
+
+// Generate compiles the schema and renders go packages for it and all its imports
+func Generate(ctx context.Context, opts Options) (*Result, error) {
+	if err := opts.Template.Validate(); err != nil {
+		return nil, err
+	}
+	ws, doc, err := loadWorkspace(opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, sch := range ws.Cache {
-		if sch.Empty() {
-			continue
+	schemas := schemasToGenerate(ws, opts.Template, opts.logger())
+	if err := mergeImportCycles(schemas, opts.logger()); err != nil {
+		return nil, err
+	}
+	res := Result{Workspace: ws, Files: map[string][]byte{}}
+	for _, pkg := range packagesOf(schemas) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		files, err := template.RenderPackage(pkg, opts.Template)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if err := res.add(file); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if doc != nil {
+		if err := res.addClients(doc, opts); err != nil {
+			return nil, err
+		}
+	}
+	// Files are written only once all of them are known not to conflict
+	if opts.Sink == nil {
+		return &res, nil
+	}
+	for _, path := range res.paths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
-		if err := template.GenerateTypes(sch, outputDir); err != nil {
-			return err
+		opts.logger().Infof("\tGenerating '%s/%s'", opts.OutputDir, path)
+		if err := opts.Sink.WriteFile(path, res.Files[path]); err != nil {
+			return nil, err
 		}
 	}
+	return &res, nil
+}
 
+// packagesOf groups schemas by the go package they are generated to, in generation order
+func packagesOf(schemas []*xsd.Schema) [][]*xsd.Schema {
+	index := map[string]int{}
+	var packages [][]*xsd.Schema
+	for _, sch := range schemas {
+		idx, found := index[sch.GoPackageName()]
+		if !found {
+			idx = len(packages)
+			index[sch.GoPackageName()] = idx
+			packages = append(packages, nil)
+		}
+		packages[idx] = append(packages[idx], sch)
+	}
+	return packages
+}
+
+// add records generated file, unless other schema generated the same path already
+func (r *Result) add(file template.File) error {
+	if _, found := r.Files[file.Path]; found {
+		return fmt.Errorf("Several schemas generate '%s', consider using source layout", file.Path)
+	}
+	r.Files[file.Path] = file.Content
+	r.paths = append(r.paths, file.Path)
 	return nil
 }
+
+func (opts Options) logger() xsd.Logger {
+	return xsd.OrDiscard(opts.Logger)
+}
+
+// loadWorkspace loads root schemas, schemas converted from opts.DTDPath and opts.RNGPath and
+// schemas embedded in opts.WSDLPath, the WSDL document is returned when there is one
+func loadWorkspace(opts Options) (*xsd.Workspace, *wsdl.Document, error) {
+	roots, err := opts.roots()
+	if err != nil {
+		return nil, nil, err
+	}
+	ws, err := xsd.NewWorkspaceWithOptions(xsd.WorkspaceOptions{
+		FS:            opts.FS,
+		GoModulesPath: fmt.Sprintf("%s/%s", opts.GoModule, opts.OutputDir),
+		Logger:        opts.logger(),
+		Catalog:       opts.Catalog,
+		Resolver:      opts.Resolver,
+		SchemaDirs:    opts.SchemaDirs,
+		XSDVersion:    opts.XSDVersion,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, root := range roots {
+		opts.logger().Infof("Processing '%s'", root)
+		if _, err := ws.Load(root); err != nil {
+			return nil, nil, err
+		}
+	}
+	if opts.DTDPath != "" {
+		if err := loadDTD(opts, ws); err != nil {
+			return nil, nil, err
+		}
+	}
+	if opts.RNGPath != "" {
+		if err := loadRNG(opts, ws); err != nil {
+			return nil, nil, err
+		}
+	}
+	if opts.WSDLPath == "" {
+		return ws, nil, nil
+	}
+	doc, err := loadWSDL(opts, ws)
+	return ws, doc, err
+}
+
+// schemasToGenerate lists non-empty schemas of the workspace in generation order
+func schemasToGenerate(ws *xsd.Workspace, opts template.Options, logger xsd.Logger) []*xsd.Schema {
+	var schemas []*xsd.Schema
+	for _, sch := range ws.Schemas() {
+		if sch.Empty() {
+			logger.Verbosef("\tSchema of namespace '%s' declares no elements nor complex types, skipped", sch.TargetNamespace)
+			continue
+		}
+		schemas = append(schemas, sch)
+	}
+	if opts.Order == xsd.TypeOrderName {
+		sort.SliceStable(schemas, func(i, j int) bool {
+			return schemas[i].GoPackageName() < schemas[j].GoPackageName()
+		})
+	}
+	return schemas
+}
@@ -0,0 +1,43 @@
+package xsd2go
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gocomply/xsd2go/pkg/infer"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Infer generates XSD file describing given sample XML documents, progress messages go to
+// logger, nil discards them
+func Infer(xmlPaths []string, xsdPath string, opts infer.Options, logger xsd.Logger) error {
+	logger = xsd.OrDiscard(logger)
+	inferrer := infer.New(opts)
+	for _, xmlPath := range xmlPaths {
+		logger.Infof("Processing '%s'", xmlPath)
+		if err := inferFile(inferrer, xmlPath); err != nil {
+			return fmt.Errorf("%s: %s", xmlPath, err)
+		}
+	}
+	sch, err := inferrer.Schema()
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("\tGenerating '%s'", xsdPath)
+	f, err := os.Create(xsdPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return sch.Write(f)
+}
+
+func inferFile(inferrer *infer.Inferrer, xmlPath string) error {
+	f, err := os.Open(xmlPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return inferrer.Add(f)
+}
@@ -0,0 +1,93 @@
+package xsd2go
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// roots lists root schemas given by XSDPath and XSDPaths. Glob patterns and directories
+// expand to the *.xsd files they contain, each file is listed once.
+func (opts Options) roots() ([]string, error) {
+	var patterns []string
+	if opts.XSDPath != "" {
+		patterns = append(patterns, opts.XSDPath)
+	}
+	patterns = append(patterns, opts.XSDPaths...)
+	if len(patterns) == 0 {
+		if opts.WSDLPath != "" || opts.DTDPath != "" || opts.RNGPath != "" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("No XSD file given")
+	}
+
+	var res []string
+	seen := map[string]bool{}
+	for _, pattern := range patterns {
+		files, err := expandInput(opts.FS, pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("No XSD files match '%s'", pattern)
+		}
+		for _, file := range files {
+			if !seen[file] {
+				seen[file] = true
+				res = append(res, file)
+			}
+		}
+	}
+	return res, nil
+}
+
+func expandInput(fsys fs.FS, pattern string) ([]string, error) {
+	if strings.Contains(pattern, "://") {
+		return []string{pattern}, nil
+	}
+	var matches []string
+	var err error
+	if fsys != nil {
+		matches, err = fs.Glob(fsys, pattern)
+	} else {
+		matches, err = filepath.Glob(pattern)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, match := range matches {
+		var info fs.FileInfo
+		if fsys != nil {
+			info, err = fs.Stat(fsys, match)
+		} else {
+			info, err = os.Stat(match)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			res = append(res, match)
+			continue
+		}
+		walk := func(p string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && path.Ext(p) == ".xsd" {
+				res = append(res, p)
+			}
+			return err
+		}
+		if fsys != nil {
+			err = fs.WalkDir(fsys, match, walk)
+		} else {
+			err = filepath.WalkDir(match, walk)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
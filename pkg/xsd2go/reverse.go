@@ -0,0 +1,27 @@
+package xsd2go
+
+import (
+	"os"
+
+	"github.com/gocomply/xsd2go/pkg/reverse"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Reverse generates XSD file describing xml-tagged structs of given go package, progress
+// messages go to logger, nil discards them
+func Reverse(goPackageDir, xsdPath string, opts reverse.Options, logger xsd.Logger) error {
+	logger = xsd.OrDiscard(logger)
+	logger.Infof("Processing '%s'", goPackageDir)
+	sch, err := reverse.Schema(goPackageDir, opts)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("\tGenerating '%s'", xsdPath)
+	f, err := os.Create(xsdPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return sch.Write(f)
+}
@@ -0,0 +1,35 @@
+package xsd2go
+
+import (
+	"bytes"
+	"io/fs"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/gocomply/xsd2go/pkg/rng"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// loadRNG converts opts.RNGPath to schema and loads it to the workspace
+func loadRNG(opts Options, ws *xsd.Workspace) error {
+	opts.logger().Infof("Processing '%s'", opts.RNGPath)
+	g, err := rng.Parse(opts.RNGPath, func(path string) ([]byte, error) {
+		if opts.FS != nil {
+			return fs.ReadFile(opts.FS, path)
+		}
+		return ioutil.ReadFile(filepath.FromSlash(path))
+	})
+	if err != nil {
+		return err
+	}
+	sch, err := g.Schema()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := sch.Write(&buf); err != nil {
+		return err
+	}
+	_, err = ws.LoadSources(xsd.Source{Path: g.SchemaPath(), Data: buf.Bytes()})
+	return err
+}
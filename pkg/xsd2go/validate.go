@@ -0,0 +1,49 @@
+package xsd2go
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// Validate checks xml documents against given XSD and reports all violations found to
+// opts.Logger. Schemas are loaded with opts, the same way Generate loads them. Returns error
+// when any document is invalid.
+func Validate(xsdPath string, xmlPaths []string, opts xsd.WorkspaceOptions) error {
+	logger := xsd.OrDiscard(opts.Logger)
+	opts.Logger = logger
+	ws, err := xsd.NewWorkspaceWithOptions(opts, xsdPath)
+	if err != nil {
+		return err
+	}
+
+	invalid := 0
+	for _, xmlPath := range xmlPaths {
+		errs, err := validateFile(ws, xmlPath)
+		if err != nil {
+			return fmt.Errorf("%s: %s", xmlPath, err)
+		}
+		for _, e := range errs {
+			logger.Infof("%s:%s", xmlPath, e.Error())
+		}
+		if len(errs) > 0 {
+			invalid++
+		} else {
+			logger.Infof("%s: valid", xmlPath)
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d documents failed validation", invalid, len(xmlPaths))
+	}
+	return nil
+}
+
+func validateFile(ws *xsd.Workspace, xmlPath string) ([]xsd.ValidationError, error) {
+	f, err := os.Open(xmlPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ws.Validate(f)
+}
@@ -0,0 +1,63 @@
+package xsd2go
+
+import (
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/wsdl"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+)
+
+// loadWSDL parses opts.WSDLPath and loads schemas embedded in its wsdl:types to the workspace
+func loadWSDL(opts Options, ws *xsd.Workspace) (*wsdl.Document, error) {
+	opts.logger().Infof("Processing '%s'", opts.WSDLPath)
+	var data []byte
+	var err error
+	if opts.FS != nil {
+		data, err = fs.ReadFile(opts.FS, opts.WSDLPath)
+	} else {
+		data, err = ioutil.ReadFile(opts.WSDLPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	doc, err := wsdl.Parse(opts.WSDLPath, data)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ws.LoadSources(doc.Schemas()...); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// addClients renders SOAP client stubs of the WSDL services, each to its own go package
+func (r *Result) addClients(doc *wsdl.Document, opts Options) error {
+	services, err := doc.Services(r.Workspace)
+	if err != nil {
+		return err
+	}
+	for idx := range services {
+		service := &services[idx]
+		if len(service.Ports) == 0 {
+			opts.logger().Verbosef("\tService %s has no SOAP ports, skipped", service.Name)
+			continue
+		}
+		for _, path := range r.paths {
+			if strings.HasPrefix(path, service.GoPackageName+"/") {
+				return fmt.Errorf("Client of service %s would share go package '%s' with generated models", service.Name, service.GoPackageName)
+			}
+		}
+		file, err := template.RenderClient(service)
+		if err != nil {
+			return err
+		}
+		if err := r.add(*file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
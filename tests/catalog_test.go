@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogResolve(t *testing.T) {
+	catalog, err := xsd.LoadCatalog(nil, "testdata/catalog/catalog.xml")
+	assert.Nil(t, err)
+
+	for location, expected := range map[string]string{
+		"http://schemas.example.com/address/1.0/address.xsd": "testdata/catalog/vendor/address/address.xsd",
+		"http://example.com/payment":                         "testdata/catalog/vendor/payment/payment.xsd",
+		"https://cdn.example.org/types/common.xsd":           "testdata/catalog/vendor/cdn/types/common.xsd",
+	} {
+		target, found := catalog.Resolve(location)
+		assert.True(t, found, location)
+		assert.Equal(t, expected, target)
+	}
+	_, found := catalog.Resolve("http://example.com/unknown.xsd")
+	assert.False(t, found)
+}
+
+func TestCatalogImports(t *testing.T) {
+	catalog, err := xsd.LoadCatalog(nil, "testdata/catalog/catalog.xml")
+	assert.Nil(t, err)
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:  "testdata/catalog/order.xsd",
+		GoModule: "example.com/catalog",
+		Catalog:  catalog,
+	})
+	assert.Nil(t, err)
+	var loaded []string
+	for _, sch := range res.Workspace.Schemas() {
+		loaded = append(loaded, sch.TargetNamespace)
+	}
+	assert.Equal(t, []string{
+		"http://example.com/order",
+		"http://schemas.example.com/address",
+		"http://example.com/payment",
+		"http://cdn.example.org/common",
+		"http://cdn.example.org/currency",
+	}, loaded)
+	assert.Equal(t, []string{"addr/models.go", "com/models.go", "ord/models.go", "pay/models.go"}, sortedKeys(toStrings(res.Files)))
+
+	_, err = xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/catalog/order.xsd"})
+	assert.EqualError(t, err, "Cannot load http://schemas.example.com/address/1.0/address.xsd imported by testdata/catalog/order.xsd: no XML catalog entry matches and fetching of remote schemas is disabled")
+}
+
+func TestCatalogValidate(t *testing.T) {
+	// Validation resolves imports the same way as code generation does
+	err := xsd2go.Validate("testdata/catalog/order.xsd", []string{"testdata/catalog/order.xml"}, xsd.WorkspaceOptions{})
+	assert.EqualError(t, err, "Cannot load http://schemas.example.com/address/1.0/address.xsd imported by testdata/catalog/order.xsd: no XML catalog entry matches and fetching of remote schemas is disabled")
+
+	catalog, err := xsd.LoadCatalog(nil, "testdata/catalog/catalog.xml")
+	assert.Nil(t, err)
+	err = xsd2go.Validate("testdata/catalog/order.xsd", []string{"testdata/catalog/order.xml"}, xsd.WorkspaceOptions{Catalog: catalog})
+	assert.Nil(t, err)
+}
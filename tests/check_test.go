@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUpToDate(t *testing.T) {
+	var diff bytes.Buffer
+	opts := checkOptions("github.com/gocomply/xsd2go/tests", "codec/codecs", template.Options{XMLCodecs: true})
+	opts.Diff = &diff
+	assert.Nil(t, xsd2go.Check(context.Background(), opts))
+	assert.Empty(t, diff.String())
+}
+
+func TestCheckDetectsStaleFiles(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	err = xsd2go.Convert("testdata/codec/inventory.xsd", "example.com/check", dname)
+	assert.Nil(t, err)
+	assert.Nil(t, xsd2go.Check(context.Background(), checkOptions("example.com/check", dname, template.Options{})))
+
+	// Different options would produce different code
+	err = xsd2go.Check(context.Background(), checkOptions("example.com/check", dname, template.Options{XMLCodecs: true}))
+	assert.EqualError(t, err, "1 generated file(s) in '"+dname+"' are out of date")
+
+	models := filepath.Join(dname, "inv", "models.go")
+	before, err := ioutil.ReadFile(models)
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(models, append(before, "// edited\n"...), 0644))
+	assert.Nil(t, os.Remove(filepath.Join(dname, "inv", "stream.go")))
+	var diff bytes.Buffer
+	opts := checkOptions("example.com/check", dname, template.Options{})
+	opts.Diff = &diff
+	err = xsd2go.Check(context.Background(), opts)
+	assert.EqualError(t, err, "2 generated file(s) in '"+dname+"' are out of date")
+	assert.Contains(t, diff.String(), "--- a/"+dname+"/inv/models.go\n")
+	assert.Contains(t, diff.String(), "\n-// edited\n")
+	assert.Contains(t, diff.String(), "+++ b/"+dname+"/inv/stream.go\n")
+
+	// Check never writes
+	after, err := ioutil.ReadFile(models)
+	assert.Nil(t, err)
+	assert.Equal(t, string(before)+"// edited\n", string(after))
+	assert.NoFileExists(t, filepath.Join(dname, "inv", "stream.go"))
+}
+
+func checkOptions(goModule, outputDir string, opts template.Options) xsd2go.Options {
+	return xsd2go.Options{
+		XSDPath:   "testdata/codec/inventory.xsd",
+		GoModule:  goModule,
+		OutputDir: outputDir,
+		Template:  opts,
+	}
+}
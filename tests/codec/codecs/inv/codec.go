@@ -0,0 +1,345 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Reflection-free xml codecs for http://example.com/inventory
+package inv
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// UnmarshalXML decodes Inventory without encoding/xml reflection
+func (v *Inventory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "inventory" {
+		return xml.UnmarshalError("expected element type <inventory> but have <" + start.Name.Local + ">")
+	}
+	v.XMLName = start.Name
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "version":
+			v.Version = attr.Value
+		case "updated":
+			v.Updated = attr.Value
+		}
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "warehouse":
+				v.Warehouse = append(v.Warehouse, WarehouseType{})
+				if err := v.Warehouse[len(v.Warehouse)-1].UnmarshalXML(d, t); err != nil {
+					return err
+				}
+			case "description":
+				if err := d.DecodeElement(&v.Description, &t); err != nil {
+					return err
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML encodes Inventory without encoding/xml reflection
+func (v Inventory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "inventory"}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "version"}, Value: v.Version})
+	if v.Updated != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "updated"}, Value: v.Updated})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, item := range v.Warehouse {
+		if err := item.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "warehouse"}}); err != nil {
+			return err
+		}
+	}
+	if err := e.EncodeElement(v.Description, xml.StartElement{Name: xml.Name{Local: "description"}}); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes Address without encoding/xml reflection
+func (v *Address) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "address" {
+		return xml.UnmarshalError("expected element type <address> but have <" + start.Name.Local + ">")
+	}
+	v.XMLName = start.Name
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "street":
+				value, err := codecText(d)
+				if err != nil {
+					return err
+				}
+				v.Street = value
+			case "city":
+				value, err := codecText(d)
+				if err != nil {
+					return err
+				}
+				v.City = value
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML encodes Address without encoding/xml reflection
+func (v Address) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "address"}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := codecEncodeText(e, "street", v.Street); err != nil {
+		return err
+	}
+	if err := codecEncodeText(e, "city", v.City); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes WarehouseType without encoding/xml reflection
+func (v *WarehouseType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "code":
+			v.Code = attr.Value
+		}
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "name":
+				value, err := codecText(d)
+				if err != nil {
+					return err
+				}
+				v.Name = value
+			case "capacity":
+				value, err := codecInt(d, 0)
+				if err != nil {
+					return err
+				}
+				v.Capacity = int(value)
+			case "temperature":
+				value, err := codecFloat(d, 64)
+				if err != nil {
+					return err
+				}
+				converted := float64(value)
+				v.Temperature = &converted
+			case "active":
+				value, err := codecBool(d)
+				if err != nil {
+					return err
+				}
+				v.Active = value
+			case "dock":
+				value, err := codecUint(d, 16)
+				if err != nil {
+					return err
+				}
+				v.Dock = append(v.Dock, uint16(value))
+			case "address":
+				if err := v.Address.UnmarshalXML(d, t); err != nil {
+					return err
+				}
+			case "item":
+				v.Item = append(v.Item, ItemType{})
+				if err := v.Item[len(v.Item)-1].UnmarshalXML(d, t); err != nil {
+					return err
+				}
+			case "note":
+				value, err := codecText(d)
+				if err != nil {
+					return err
+				}
+				v.Note = append(v.Note, value)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML encodes WarehouseType without encoding/xml reflection
+func (v WarehouseType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "code"}, Value: v.Code})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := codecEncodeText(e, "name", v.Name); err != nil {
+		return err
+	}
+	if err := codecEncodeText(e, "capacity", strconv.FormatInt(int64(v.Capacity), 10)); err != nil {
+		return err
+	}
+	if v.Temperature != nil {
+		if err := codecEncodeText(e, "temperature", strconv.FormatFloat(float64(*v.Temperature), 'g', -1, 64)); err != nil {
+			return err
+		}
+	}
+	if err := codecEncodeText(e, "active", strconv.FormatBool(v.Active)); err != nil {
+		return err
+	}
+	for _, item := range v.Dock {
+		if err := codecEncodeText(e, "dock", strconv.FormatUint(uint64(item), 10)); err != nil {
+			return err
+		}
+	}
+	if err := v.Address.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "address"}}); err != nil {
+		return err
+	}
+	for _, item := range v.Item {
+		if err := item.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "item"}}); err != nil {
+			return err
+		}
+	}
+	for _, item := range v.Note {
+		if err := codecEncodeText(e, "note", item); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes ItemType without encoding/xml reflection
+func (v *ItemType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "sku":
+			v.Sku = attr.Value
+		case "quantity":
+			v.Quantity = attr.Value
+		}
+	}
+	var text []byte
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.CharData:
+			text = append(text, t...)
+		case xml.EndElement:
+			v.Text = string(text)
+			return nil
+		}
+	}
+}
+
+// MarshalXML encodes ItemType without encoding/xml reflection
+func (v ItemType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "sku"}, Value: v.Sku})
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "quantity"}, Value: v.Quantity})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.EncodeToken(xml.CharData(v.Text)); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// codecText returns character data of current element, nested elements are skipped
+func codecText(d *xml.Decoder) (string, error) {
+	var text []byte
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			text = append(text, t...)
+		case xml.StartElement:
+			if err := d.Skip(); err != nil {
+				return "", err
+			}
+		case xml.EndElement:
+			return string(text), nil
+		}
+	}
+}
+
+func codecInt(d *xml.Decoder, bits int) (int64, error) {
+	text, err := codecText(d)
+	if err != nil || text == "" {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(text), 10, bits)
+}
+
+func codecUint(d *xml.Decoder, bits int) (uint64, error) {
+	text, err := codecText(d)
+	if err != nil || text == "" {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(text), 10, bits)
+}
+
+func codecFloat(d *xml.Decoder, bits int) (float64, error) {
+	text, err := codecText(d)
+	if err != nil || text == "" {
+		return 0, err
+	}
+	return strconv.ParseFloat(strings.TrimSpace(text), bits)
+}
+
+func codecBool(d *xml.Decoder) (bool, error) {
+	text, err := codecText(d)
+	if err != nil || text == "" {
+		return false, err
+	}
+	return strconv.ParseBool(strings.TrimSpace(text))
+}
+
+func codecEncodeText(e *xml.Encoder, name, text string) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.EncodeToken(xml.CharData(text)); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
@@ -0,0 +1,63 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Models for http://example.com/inventory
+package inv
+
+import (
+	"encoding/xml"
+)
+
+// Element
+type Inventory struct {
+	XMLName xml.Name `xml:"inventory"`
+
+	Version string `xml:"version,attr"`
+
+	Updated string `xml:"updated,attr,omitempty"`
+
+	Warehouse []WarehouseType `xml:"warehouse"`
+
+	Description *DescriptionType `xml:"description"`
+}
+
+// Element
+type Address struct {
+	XMLName xml.Name `xml:"address"`
+
+	Street string `xml:"street"`
+
+	City string `xml:"city"`
+}
+
+// XSD ComplexType declarations
+
+type WarehouseType struct {
+	Code string `xml:"code,attr"`
+
+	Name string `xml:"name"`
+
+	Capacity int `xml:"capacity"`
+
+	Temperature *float64 `xml:"temperature"`
+
+	Active bool `xml:"active"`
+
+	Dock []uint16 `xml:"dock"`
+
+	Address Address `xml:"address"`
+
+	Item []ItemType `xml:"item"`
+
+	Note []string `xml:"note"`
+}
+
+type ItemType struct {
+	Sku string `xml:"sku,attr"`
+
+	Quantity string `xml:"quantity,attr"`
+
+	Text string `xml:",chardata"`
+}
+
+type DescriptionType struct {
+	InnerXml string `xml:",innerxml"`
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/codec/codecs/inv"
+	reflection "github.com/gocomply/xsd2go/tests/codec/reflection/inv"
+	"github.com/stretchr/testify/assert"
+)
+
+// Packages under codec/ are generated from testdata/codec/inventory.xsd, with and without
+// --xml-codecs, to compare both decoding paths
+func TestCodecPackagesUpToDate(t *testing.T) {
+	assertGeneratedUpToDate(t, "testdata/codec/inventory.xsd", "codec/reflection", template.Options{})
+	assertGeneratedUpToDate(t, "testdata/codec/inventory.xsd", "codec/codecs", template.Options{XMLCodecs: true})
+}
+
+// assertGeneratedUpToDate compares go package committed under dir with freshly generated one
+func assertGeneratedUpToDate(t *testing.T, xsdPath, dir string, opts template.Options) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	err = xsd2go.ConvertWithOptions(xsdPath, "github.com/gocomply/xsd2go/tests/"+dir, dname, opts)
+	assert.Nil(t, err)
+
+	generated, err := filepath.Glob(filepath.Join(dname, "*", "*"))
+	assert.Nil(t, err)
+	committed, err := filepath.Glob(filepath.Join(dir, "*", "*"))
+	assert.Nil(t, err)
+	assert.Equal(t, baseNames(committed), baseNames(generated))
+	for _, path := range generated {
+		rel, err := filepath.Rel(dname, path)
+		assert.Nil(t, err)
+		expected, err := ioutil.ReadFile(filepath.Join(dir, rel))
+		assert.Nil(t, err)
+		actual, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Equal(t, string(expected), string(actual), "%s is out of date, re-generate it", filepath.Join(dir, rel))
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/codec/inventory.xml")
+	assert.Nil(t, err)
+
+	var slow reflection.Inventory
+	assert.Nil(t, xml.Unmarshal(data, &slow))
+	var fast inv.Inventory
+	assert.Nil(t, xml.Unmarshal(data, &fast))
+	assert.Equal(t, jsonString(t, slow), jsonString(t, fast))
+	assert.Equal(t, "North & South", fast.Warehouse[0].Name)
+	assert.Equal(t, []uint16{1, 7}, fast.Warehouse[0].Dock)
+	assert.Equal(t, -4.5, *fast.Warehouse[0].Temperature)
+	assert.Equal(t, "Stock of <b>all</b> warehouses", fast.Description.InnerXml)
+
+	slowOut, err := xml.Marshal(slow)
+	assert.Nil(t, err)
+	fastOut, err := xml.Marshal(fast)
+	assert.Nil(t, err)
+	assert.Equal(t, string(slowOut), string(fastOut))
+
+	var slowAgain reflection.Inventory
+	assert.Nil(t, xml.Unmarshal(slowOut, &slowAgain))
+	var fastAgain inv.Inventory
+	assert.Nil(t, xml.Unmarshal(fastOut, &fastAgain))
+	assert.Equal(t, jsonString(t, slowAgain), jsonString(t, fastAgain))
+	assert.Equal(t, fast.Warehouse[0].Item, fastAgain.Warehouse[0].Item)
+}
+
+func TestCodecErrors(t *testing.T) {
+	for _, doc := range []string{
+		`<warehouse code="W1"/>`,
+		`<inventory><warehouse><capacity>many</capacity></warehouse></inventory>`,
+		`<inventory><warehouse><active>maybe</active></warehouse></inventory>`,
+		`<inventory><warehouse><name>unterminated</warehouse></inventory>`,
+	} {
+		var slow reflection.Inventory
+		var fast inv.Inventory
+		slowErr := xml.Unmarshal([]byte(doc), &slow)
+		fastErr := xml.Unmarshal([]byte(doc), &fast)
+		assert.NotNil(t, slowErr, doc)
+		assert.Equal(t, slowErr, fastErr, doc)
+	}
+}
+
+func jsonString(t *testing.T, v interface{}) string {
+	data, err := json.Marshal(v)
+	assert.Nil(t, err)
+	return string(data)
+}
+
+func benchmarkDocument(b *testing.B) []byte {
+	data, err := ioutil.ReadFile("testdata/codec/inventory.xml")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return data
+}
+
+func BenchmarkUnmarshalReflection(b *testing.B) {
+	data := benchmarkDocument(b)
+	for i := 0; i < b.N; i++ {
+		var v reflection.Inventory
+		if err := xml.Unmarshal(data, &v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalCodecs(b *testing.B) {
+	data := benchmarkDocument(b)
+	for i := 0; i < b.N; i++ {
+		var v inv.Inventory
+		if err := xml.Unmarshal(data, &v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalReflection(b *testing.B) {
+	var v reflection.Inventory
+	if err := xml.Unmarshal(benchmarkDocument(b), &v); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := xml.Marshal(v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalCodecs(b *testing.B) {
+	var v inv.Inventory
+	if err := xml.Unmarshal(benchmarkDocument(b), &v); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := xml.Marshal(v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
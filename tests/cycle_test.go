@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/importcycle/ord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalCacheKeys(t *testing.T) {
+	dir, err := filepath.Abs("testdata/cycle")
+	assert.Nil(t, err)
+	link := filepath.Join(t.TempDir(), "linked")
+	assert.Nil(t, os.Symlink(dir, link))
+
+	ws, err := xsd.NewWorkspaceWithOptions(xsd.WorkspaceOptions{},
+		"testdata/cycle/orders.xsd",
+		"testdata/deterministic/../cycle/customers.xsd",
+		filepath.Join(dir, "orders.xsd"),
+		filepath.Join(link, "customers.xsd"),
+	)
+	assert.Nil(t, err)
+	assert.Len(t, ws.Schemas(), 2)
+	assert.Same(t, ws.Schema("testdata/cycle/customers.xsd"), ws.Schema(filepath.Join(link, "customers.xsd")))
+}
+
+func TestImportCycle(t *testing.T) {
+	// orders.xsd and customers.xsd import each other and refer to each other's types
+	assert.Nil(t, xsd2go.Validate("testdata/cycle/orders.xsd", []string{"testdata/cycle/order.xml"}, xsd.WorkspaceOptions{}))
+
+	// Go packages cannot import each other, both namespaces are generated into one package
+	var logs bytes.Buffer
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath: "testdata/cycle/orders.xsd",
+		Logger:  xsd.NewLogger(&logs, xsd.LevelInfo),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ord/orders_stream.go", "ord/orders.go", "ord/customers_stream.go", "ord/customers.go"}, res.Paths())
+	assert.Contains(t, logs.String(), "\tNamespaces 'http://example.com/cycle/orders', 'http://example.com/cycle/customers' refer to each other's types, generating them into go package ord\n")
+	assertGeneratedUpToDate(t, "testdata/cycle/orders.xsd", "importcycle", template.Options{})
+
+	data, err := ioutil.ReadFile("testdata/cycle/order.xml")
+	assert.Nil(t, err)
+	var order ord.Order
+	assert.Nil(t, xml.Unmarshal(data, &order))
+	assert.Equal(t, "Ann", order.Customer.Name)
+	assert.Equal(t, "0", order.Customer.LastOrder.OrderId)
+
+	// Schemas of the merged package each get their streaming decoders, helpers are declared once
+	f, err := os.Open("testdata/cycle/orders.xml")
+	assert.Nil(t, err)
+	defer f.Close()
+	var customers []string
+	err = ord.StreamOrders(context.Background(), f, func(o *ord.Order) error {
+		customers = append(customers, o.Customer.Name)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Ann", "Bob"}, customers)
+
+	for _, layout := range []string{"", template.LayoutSource} {
+		opts := template.Options{Layout: layout, XMLCodecs: true}
+		res, err = xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/cycle/orders.xsd", Template: opts})
+		assert.Nil(t, err)
+		assert.Contains(t, res.Paths(), "ord/orders_codec.go")
+		assert.Contains(t, res.Paths(), "ord/customers_codec.go")
+		assertVetClean(t, xsd2go.Options{XSDPath: "testdata/cycle/orders.xsd", Template: opts})
+	}
+
+	_, err = xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/cycle/clash/boxes.xsd"})
+	assert.EqualError(t, err, "Namespaces 'http://example.com/cycle/boxes' and 'http://example.com/cycle/crates' refer to each other's types, but cannot share go package box as both declare ItemType")
+
+	// Namespaces sharing the xmlns prefix share the go package as well
+	assertGeneratedUpToDate(t, "testdata/cycle/shared/orders.xsd", "cycle", template.Options{Layout: template.LayoutSource})
+}
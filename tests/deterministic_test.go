@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedOutputIsDeterministic(t *testing.T) {
+	for _, order := range []string{xsd.TypeOrderDeclaration, xsd.TypeOrderName} {
+		opts := template.Options{Order: order}
+		first := generateFiles(t, "testdata/deterministic/main.xsd", opts)
+		assert.Equal(t, []string{"address/models.go", "main/models.go", "main/stream.go", "payment/models.go"}, sortedKeys(first))
+		assertVetClean(t, xsd2go.Options{XSDPath: "testdata/deterministic/main.xsd", Template: opts})
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, first, generateFiles(t, "testdata/deterministic/main.xsd", opts), "order %s", order)
+		}
+	}
+}
+
+func TestGeneratedTypesOrderedByName(t *testing.T) {
+	declared := generateFiles(t, "testdata/deterministic/main.xsd", template.Options{})["main/models.go"]
+	assert.True(t, strings.Index(declared, "type ZebraType") < strings.Index(declared, "type AardvarkType"))
+
+	sorted := generateFiles(t, "testdata/deterministic/main.xsd", template.Options{Order: xsd.TypeOrderName})["main/models.go"]
+	assert.True(t, strings.Index(sorted, "type AardvarkType") < strings.Index(sorted, "type ZebraType"))
+	assert.True(t, strings.Index(sorted, "type Shop struct") < strings.Index(sorted, "type ShopCustomer struct"))
+	// fields keep schema order as it drives order of marshalled elements
+	assert.True(t, strings.Index(sorted, "Zebra ZebraType") < strings.Index(sorted, "Customer []ShopCustomer"))
+}
+
+// generateFiles converts xsd into fixed output directory and returns generated files keyed by relative path
+func generateFiles(t *testing.T, xsdPath string, opts template.Options) map[string]string {
+	dname := filepath.Join(os.TempDir(), "xsd2go_tests_deterministic")
+	assert.Nil(t, os.RemoveAll(dname))
+	defer os.RemoveAll(dname)
+
+	err := xsd2go.ConvertWithOptions(xsdPath, "example.com/deterministic", dname, opts)
+	assert.Nil(t, err)
+
+	files := map[string]string{}
+	paths, err := filepath.Glob(filepath.Join(dname, "*", "*"))
+	assert.Nil(t, err)
+	for _, path := range paths {
+		rel, err := filepath.Rel(dname, path)
+		assert.Nil(t, err)
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		files[filepath.ToSlash(rel)] = string(data)
+	}
+	return files
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// assertVetClean generates code in memory and runs go vet over it
+func assertVetClean(t *testing.T, opts xsd2go.Options) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	opts.GoModule, opts.OutputDir = "example.com/vet", "models"
+	res, err := xsd2go.Generate(context.Background(), opts)
+	assert.Nil(t, err)
+	files := map[string][]byte{"go.mod": []byte("module example.com/vet\n\ngo 1.16\n")}
+	for path, content := range res.Files {
+		files["models/"+path] = content
+	}
+	for path, content := range files {
+		fullPath := filepath.Join(dname, filepath.FromSlash(path))
+		assert.Nil(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+		assert.Nil(t, ioutil.WriteFile(fullPath, content, 0644))
+	}
+	cmd := exec.Command("go", "vet", "./...")
+	cmd.Dir = dname
+	out, err := cmd.CombinedOutput()
+	assert.Nil(t, err, "go vet of generated code failed:\n%s", out)
+}
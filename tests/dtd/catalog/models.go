@@ -0,0 +1,121 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Models for
+package catalog
+
+import (
+	"encoding/xml"
+)
+
+// Element
+type Link struct {
+	XMLName xml.Name `xml:"link"`
+
+	Href string `xml:"href,attr"`
+
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// Element
+type Catalog struct {
+	XMLName xml.Name `xml:"catalog"`
+
+	Version string `xml:"version,attr,omitempty"`
+
+	Lang string `xml:"lang,attr,omitempty"`
+
+	Title Title `xml:"title"`
+
+	Product []Product `xml:"product"`
+
+	Note []Note `xml:"note"`
+
+	Link []Link `xml:"link"`
+}
+
+// Element
+type Title struct {
+	XMLName xml.Name `xml:"title"`
+
+	Text string `xml:",chardata"`
+}
+
+// Element
+type Product struct {
+	XMLName xml.Name `xml:"product"`
+
+	Id string `xml:"id,attr"`
+
+	Related string `xml:"related,attr,omitempty"`
+
+	Status string `xml:"status,attr,omitempty"`
+
+	Kind string `xml:"kind,attr,omitempty"`
+
+	Name Name `xml:"name"`
+
+	Price *Price `xml:"price"`
+
+	Description *Description `xml:"description"`
+
+	Category []Category `xml:"category"`
+
+	Tag []Tag `xml:"tag"`
+}
+
+// Element
+type Name struct {
+	XMLName xml.Name `xml:"name"`
+
+	Text string `xml:",chardata"`
+}
+
+// Element
+type Price struct {
+	XMLName xml.Name `xml:"price"`
+
+	Currency string `xml:"currency,attr,omitempty"`
+
+	Text string `xml:",chardata"`
+}
+
+// Element
+type Category struct {
+	XMLName xml.Name `xml:"category"`
+
+	Ref string `xml:"ref,attr"`
+}
+
+// Element
+type Tag struct {
+	XMLName xml.Name `xml:"tag"`
+
+	Text string `xml:",chardata"`
+}
+
+// Element
+type Description struct {
+	XMLName xml.Name `xml:"description"`
+
+	Em []Em `xml:"em"`
+
+	Code []Code `xml:"code"`
+}
+
+// Element
+type Em struct {
+	XMLName xml.Name `xml:"em"`
+
+	Text string `xml:",chardata"`
+}
+
+// Element
+type Code struct {
+	XMLName xml.Name `xml:"code"`
+
+	Text string `xml:",chardata"`
+}
+
+// Element
+type Note struct {
+	XMLName xml.Name `xml:"note"`
+}
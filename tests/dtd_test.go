@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/dtd/catalog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDTDGenerated(t *testing.T) {
+	// Package dtd/catalog is generated from catalog.dtd
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		DTDPath:   "testdata/dtd/catalog.dtd",
+		GoModule:  "github.com/gocomply/xsd2go",
+		OutputDir: "tests/dtd",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"catalog/ids.go", "catalog/stream.go", "catalog/models.go"}, res.Paths())
+	for _, path := range res.Paths() {
+		expected, err := ioutil.ReadFile(filepath.Join("dtd", path))
+		assert.Nil(t, err)
+		assert.Equal(t, string(expected), string(res.Files[path]), "dtd/%s is out of date, re-generate it", path)
+	}
+
+	assert.Empty(t, validateFile(t, res.Workspace, "testdata/dtd/catalog.xml"))
+	messages := []string{}
+	for _, e := range validateFile(t, res.Workspace, "testdata/dtd/catalog-invalid.xml") {
+		messages = append(messages, e.Error())
+	}
+	assert.Equal(t, []string{
+		"2:1: attribute version on element catalog must have fixed value '2.0'",
+		"4:3: attribute status: value 'retired' is not one of the enumerated values",
+		"4:3: required attribute id missing on element product",
+		"5:5: unexpected element price in product, expected: name",
+	}, messages)
+
+	data, err := ioutil.ReadFile("testdata/dtd/catalog.xml")
+	assert.Nil(t, err)
+	var doc catalog.Catalog
+	assert.Nil(t, xml.Unmarshal(data, &doc))
+	assert.Equal(t, "Tools", doc.Title.Text)
+	assert.Len(t, doc.Product, 2)
+	assert.Equal(t, "EUR", doc.Product[0].Price.Currency)
+	assert.Equal(t, "http://example.com/tools", doc.Link[0].Href)
+	_, err = doc.ResolveIDs()
+	assert.Nil(t, err)
+}
+
+func TestDTDErrors(t *testing.T) {
+	for dtd, expected := range map[string]string{
+		`<!ELEMENT a (b, c)><!ELEMENT b EMPTY>`:                   "Element a declared in doc.dtd refers to undeclared element c",
+		`<!ENTITY % x "&#37;x;"><!ELEMENT a (%x;)>`:               "Invalid declaration <!ELEMENT a (%x;)> in doc.dtd: Parameter entity %x; refers to itself",
+		`<!ELEMENT a (b | c, d)>`:                                 "Invalid declaration <!ELEMENT a (b | c, d)> in doc.dtd: content model mixes ',' and '|' within one group",
+		`<!ENTITY % ext SYSTEM "http://example.com/x.ent"> %ext;`: "Cannot load parameter entity %ext; from http://example.com/x.ent, only local files are supported in doc.dtd",
+	} {
+		_, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+			FS:      fstest.MapFS{"doc.dtd": &fstest.MapFile{Data: []byte(dtd)}},
+			DTDPath: "doc.dtd",
+		})
+		assert.EqualError(t, err, expected)
+	}
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gocomply/xsd2go/pkg/fetch"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchRemoteSchemas(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		http.ServeFile(w, r, filepath.Join("testdata/fetch", filepath.FromSlash(r.URL.Path)))
+	}))
+	defer server.Close()
+
+	local, err := ioutil.ReadFile("testdata/fetch/local.xsd")
+	assert.Nil(t, err)
+	fsys := fstest.MapFS{"local.xsd": &fstest.MapFile{Data: []byte(strings.Replace(string(local), `"SERVER/`, `"`+server.URL+"/", 1))}}
+
+	cacheDir, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(cacheDir)
+	generate := func(cache *fetch.Cache) (*xsd2go.Result, error) {
+		return xsd2go.Generate(context.Background(), xsd2go.Options{FS: fsys, XSDPath: "local.xsd", Resolver: cache})
+	}
+
+	// Offline run cannot succeed with empty cache
+	_, err = generate(&fetch.Cache{Dir: cacheDir, Offline: true})
+	assert.EqualError(t, err, server.URL+"/remote.xsd is not cached in '"+cacheDir+"', run fetch command first or go online")
+	assert.Empty(t, requests)
+
+	_, err = xsd2go.Fetch(xsd2go.Options{FS: fsys, XSDPath: "local.xsd", Resolver: &fetch.Cache{Dir: cacheDir, Refresh: true}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"/remote.xsd": 1, "/types/weight.xsd": 1}, requests)
+	objects, err := filepath.Glob(filepath.Join(cacheDir, "objects", "*.xsd"))
+	assert.Nil(t, err)
+	assert.Len(t, objects, 2)
+
+	// Cached schemas are used without touching the network
+	server.Close()
+	res, err := generate(&fetch.Cache{Dir: cacheDir, Offline: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"loc/models.go", "rem/models.go", "wt/models.go"}, sortedKeys(toStrings(res.Files)))
+	res, err = generate(&fetch.Cache{Dir: cacheDir})
+	assert.Nil(t, err)
+	assert.Len(t, res.Files, 3)
+	assert.Equal(t, map[string]int{"/remote.xsd": 1, "/types/weight.xsd": 1}, requests)
+}
+
+func TestFetchRemoteRoot(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata/fetch")))
+	defer server.Close()
+	cacheDir, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:  server.URL + "/remote.xsd",
+		Resolver: &fetch.Cache{Dir: cacheDir},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"rem/models.go", "wt/models.go"}, sortedKeys(toStrings(res.Files)))
+
+	_, err = xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:  server.URL + "/missing.xsd",
+		Resolver: &fetch.Cache{Dir: cacheDir},
+	})
+	assert.EqualError(t, err, "Cannot fetch "+server.URL+"/missing.xsd: 404 Not Found")
+}
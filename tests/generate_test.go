@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+type memorySink map[string]string
+
+func (s memorySink) WriteFile(path string, content []byte) error {
+	s[path] = string(content)
+	return nil
+}
+
+func TestGenerateFromFS(t *testing.T) {
+	fsys := fstest.MapFS{}
+	for _, name := range []string{"main.xsd", "address.xsd", "payment.xsd"} {
+		data, err := os.ReadFile("testdata/deterministic/" + name)
+		assert.Nil(t, err)
+		fsys["schemas/"+name] = &fstest.MapFile{Data: data}
+	}
+
+	var logs bytes.Buffer
+	sink := memorySink{}
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		FS:        fsys,
+		XSDPath:   "schemas/main.xsd",
+		GoModule:  "example.com/shop",
+		OutputDir: "gen",
+		Template:  template.Options{JSONTags: true},
+		Sink:      sink,
+		Logger:    xsd.NewLogger(&logs, xsd.LevelVerbose),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"main/stream.go", "main/models.go", "payment/models.go", "address/models.go"}, res.Paths())
+	assert.Len(t, res.Files, 4)
+	for path, content := range res.Files {
+		assert.Equal(t, string(content), sink[path])
+	}
+	assert.Contains(t, string(res.Files["main/models.go"]), `"example.com/shop/gen/address"`)
+	assert.Contains(t, string(res.Files["main/models.go"]), `json:"zebra"`)
+	assert.Len(t, res.Workspace.Schemas(), 3)
+	assert.Contains(t, logs.String(), "\tParsing: schemas/payment.xsd\n")
+	assert.Contains(t, logs.String(), "\tGenerating 'gen/address/models.go'\n")
+	assert.Contains(t, logs.String(), "\tImport of namespace 'http://example.com/address' in schemas/main.xsd resolved to schemas/address.xsd\n")
+	assert.Contains(t, logs.String(), "\tInlined xsd:element 'customer' within 'shop' generated as ShopCustomer\n")
+
+	_, err = os.Stat("gen")
+	assert.True(t, os.IsNotExist(err), "nothing shall be written to disk")
+}
+
+func TestGenerateInMemory(t *testing.T) {
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:  "testdata/codec/inventory.xsd",
+		GoModule: "example.com/inv",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"inv/models.go", "inv/stream.go"}, sortedKeys(toStrings(res.Files)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = xsd2go.Generate(ctx, xsd2go.Options{XSDPath: "testdata/codec/inventory.xsd"})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestGenerateLogLevels(t *testing.T) {
+	for level, expected := range map[xsd.Level]string{
+		xsd.LevelQuiet: "",
+		xsd.LevelInfo:  "Processing 'testdata/codec/inventory.xsd'\n\tParsing: testdata/codec/inventory.xsd\n",
+	} {
+		var logs bytes.Buffer
+		_, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+			XSDPath: "testdata/codec/inventory.xsd",
+			Logger:  xsd.NewLogger(&logs, level),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expected, logs.String())
+	}
+}
+
+func toStrings(files map[string][]byte) map[string]string {
+	res := map[string]string{}
+	for path, content := range files {
+		res[path] = string(content)
+	}
+	return res
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/tests/groups/grp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNestedGroupsGenerated(t *testing.T) {
+	// Elements of nested xsd:sequence and xsd:choice become fields of the enclosing struct,
+	// optional or repeated as the enclosing groups allow
+	assertGeneratedUpToDate(t, "testdata/groups/order.xsd", "groups", template.Options{})
+
+	ws, err := xsd.NewWorkspace("", "testdata/groups/order.xsd")
+	assert.Nil(t, err)
+	assert.Empty(t, validateFile(t, ws, "testdata/groups/order.xml"))
+
+	data, err := ioutil.ReadFile("testdata/groups/order.xml")
+	assert.Nil(t, err)
+	var order grp.Order
+	assert.Nil(t, xml.Unmarshal(data, &order))
+	assert.Equal(t, "2026-10-19", order.Placed)
+	assert.Equal(t, "Example Ltd", order.Company)
+	assert.Equal(t, "", order.Customer)
+	assert.Equal(t, []string{"A-1", "B-7"}, order.Sku)
+	assert.Equal(t, []int64{2, 1}, order.Quantity)
+	assert.Equal(t, int64(9223372036854775807), order.Total)
+}
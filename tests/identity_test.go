@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/identity/cat"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityConstraintsValidation(t *testing.T) {
+	ws, err := xsd.NewWorkspace("", "testdata/identity/catalog.xsd")
+	assert.Nil(t, err)
+
+	errs := validateFile(t, ws, "testdata/identity/valid.xml")
+	assert.Empty(t, errs)
+
+	errs = validateFile(t, ws, "testdata/identity/invalid.xml")
+	messages := []string{}
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	assert.Equal(t, []string{
+		"4:3: xsd:key product-id: duplicate value 'p1'",
+		"5:3: xsd:unique product-name: duplicate value 'Apple'",
+		"5:3: xsd:key product-id: element product does not define all key fields",
+		"8:5: xsd:keyref bundle-item: value 'p3' does not match any xsd:key product-id",
+	}, messages)
+}
+
+func TestIdentityConstraintsGenerated(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	err = xsd2go.Convert("testdata/identity/catalog.xsd", "user.com/private", dname)
+	assert.Nil(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dname, "cat", "*"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"identity.go", "models.go", "stream.go"}, baseNames(files))
+
+	models, err := ioutil.ReadFile(filepath.Join(dname, "cat", "models.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(models), "ProductId   map[string]*ProductType")
+	assert.Contains(t, string(models), "func (e *Catalog) BuildIndex() (*CatalogIndex, error)")
+	assert.Contains(t, string(models), "func (idx *CatalogIndex) ResolveBundleItem(value string) (*ProductType, bool)")
+
+	// Schemas without identity constraints do not get the helpers
+	err = xsd2go.Convert("xsd-examples/valid/simple.xsd", "user.com/private", dname)
+	assert.Nil(t, err)
+	files, err = filepath.Glob(filepath.Join(dname, "simple_schema", "*"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"models.go"}, baseNames(files))
+}
+
+func TestBuildIndex(t *testing.T) {
+	assertGeneratedUpToDate(t, "testdata/identity/catalog.xsd", "identity", template.Options{})
+
+	catalog := unmarshalCatalog(t, "testdata/identity/valid.xml")
+	idx, err := catalog.BuildIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, &catalog.Product[1], idx.ProductId["p2"])
+	assert.Equal(t, &catalog.Product[0], idx.ProductName["Apple"])
+	product, found := idx.ResolveBundleItem(catalog.Bundle[0].Item[1].Ref)
+	assert.True(t, found)
+	assert.Equal(t, "Pear", product.Name)
+	_, found = idx.ResolveBundleItem("p3")
+	assert.False(t, found)
+
+	// Product without the optional id attribute does not define the key, rather than having empty one
+	catalog = unmarshalCatalog(t, "testdata/identity/invalid.xml")
+	idx, err = catalog.BuildIndex()
+	assert.Equal(t, cat.IdentityConstraintErrors{
+		{Kind: "unique", Name: "product-name", Value: "Apple", Problem: "duplicate value"},
+		{Kind: "key", Name: "product-id", Value: "p1", Problem: "duplicate value"},
+		{Kind: "key", Name: "product-id", Problem: "selected element does not define all key fields"},
+		{Kind: "keyref", Name: "bundle-item", Value: "p3", Problem: "no key product-id matches"},
+	}, err)
+	assert.Equal(t, &catalog.Product[0], idx.ProductId["p1"])
+	assert.NotContains(t, idx.ProductId, "")
+}
+
+func unmarshalCatalog(t *testing.T, path string) *cat.Catalog {
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	var catalog cat.Catalog
+	assert.Nil(t, xml.Unmarshal(data, &catalog))
+	return &catalog
+}
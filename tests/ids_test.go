@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/ids/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDHelpersGenerated(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	err = xsd2go.Convert("testdata/ids/library.xsd", "user.com/private", dname)
+	assert.Nil(t, err)
+
+	ids, err := ioutil.ReadFile(filepath.Join(dname, "lib", "ids.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(ids), "func (e *Library) ResolveIDs() (*IDIndex, error)")
+	assert.Contains(t, string(ids), "func (idx *IDIndex) LookupBookType(id string) (*BookType, bool)")
+	assert.Contains(t, string(ids), "func (e *BookType) indexIDs(idx *IDIndex) {\n\tidx.addID(e.Id, e)\n\tidx.addIDREFS(e.Authors)\n\tfor _, value := range e.SeeAlso {\n\t\tidx.addIDREF(value)\n\t}\n}")
+	assert.NotContains(t, string(ids), "LookupLibrary")
+}
+
+func TestResolveIDs(t *testing.T) {
+	assertGeneratedUpToDate(t, "testdata/ids/library.xsd", "ids", template.Options{})
+
+	library := unmarshalLibrary(t, "testdata/ids/library.xml")
+	idx, err := library.ResolveIDs()
+	assert.Nil(t, err)
+	book, found := idx.LookupBookType("b1")
+	assert.True(t, found)
+	assert.Equal(t, &library.Book[0], book)
+	author, found := idx.LookupAuthorType(" a2 ")
+	assert.True(t, found)
+	assert.Equal(t, "John", author.Name)
+	_, found = idx.LookupAuthorType("b1")
+	assert.False(t, found)
+	authors, found := idx.ResolveAll(library.Book[0].Authors)
+	assert.True(t, found)
+	assert.Equal(t, []interface{}{&library.Author[0], &library.Author[1]}, authors)
+
+	library = unmarshalLibrary(t, "testdata/ids/broken.xml")
+	_, err = library.ResolveIDs()
+	assert.Equal(t, lib.IDErrors{
+		{Value: "a1", Problem: "duplicate xsd:ID"},
+		{Value: "a3", Problem: "dangling xsd:IDREF"},
+		{Value: "b9", Problem: "dangling xsd:IDREF"},
+	}, err)
+	assert.EqualError(t, err, "duplicate xsd:ID 'a1'\ndangling xsd:IDREF 'a3'\ndangling xsd:IDREF 'b9'")
+}
+
+func unmarshalLibrary(t *testing.T, path string) *lib.Library {
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	var library lib.Library
+	assert.Nil(t, xml.Unmarshal(data, &library))
+	return &library
+}
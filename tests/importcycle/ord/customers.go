@@ -0,0 +1,23 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Models for http://example.com/cycle/customers
+package ord
+
+import (
+	"encoding/xml"
+)
+
+// Element
+type Customers struct {
+	XMLName xml.Name `xml:"customers"`
+
+	Customer []Customer `xml:"customer"`
+}
+
+// Element
+type Customer struct {
+	XMLName xml.Name `xml:"customer"`
+
+	Name string `xml:"name"`
+
+	LastOrder *OrderRef `xml:"last-order"`
+}
@@ -0,0 +1,26 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Streaming decoders for http://example.com/cycle/customers
+package ord
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+)
+
+// StreamCustomers decodes <customer> elements of the document one at a time and passes
+// them to fn. By default elements are looked up at customers/customer; path may select
+// a different location, "*" matches any element and "**" any number of nested elements.
+// Streaming stops when ctx is done or fn returns an error.
+func StreamCustomers(ctx context.Context, r io.Reader, fn func(*Customer) error, path ...string) error {
+	if len(path) == 0 {
+		path = []string{"customers", "customer"}
+	}
+	return streamElements(ctx, r, path, func(d *xml.Decoder, start *xml.StartElement) error {
+		var v Customer
+		if err := d.DecodeElement(&v, start); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
@@ -0,0 +1,31 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Models for http://example.com/cycle/orders
+package ord
+
+import (
+	"encoding/xml"
+)
+
+// Element
+type Orders struct {
+	XMLName xml.Name `xml:"orders"`
+
+	Order []Order `xml:"order"`
+}
+
+// Element
+type Order struct {
+	XMLName xml.Name `xml:"order"`
+
+	Id string `xml:"id,attr"`
+
+	Item []string `xml:"item"`
+
+	Customer Customer `xml:"customer"`
+}
+
+// XSD ComplexType declarations
+
+type OrderRef struct {
+	OrderId string `xml:"order-id,attr"`
+}
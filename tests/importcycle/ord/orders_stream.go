@@ -0,0 +1,73 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Streaming decoders for http://example.com/cycle/orders
+package ord
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+)
+
+// StreamOrders decodes <order> elements of the document one at a time and passes
+// them to fn. By default elements are looked up at orders/order; path may select
+// a different location, "*" matches any element and "**" any number of nested elements.
+// Streaming stops when ctx is done or fn returns an error.
+func StreamOrders(ctx context.Context, r io.Reader, fn func(*Order) error, path ...string) error {
+	if len(path) == 0 {
+		path = []string{"orders", "order"}
+	}
+	return streamElements(ctx, r, path, func(d *xml.Decoder, start *xml.StartElement) error {
+		var v Order
+		if err := d.DecodeElement(&v, start); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+func streamElements(ctx context.Context, r io.Reader, path []string, decode func(*xml.Decoder, *xml.StartElement) error) error {
+	d := xml.NewDecoder(r)
+	var stack []string
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			if streamPathMatches(path, stack) {
+				if err := decode(d, &t); err != nil {
+					return err
+				}
+				stack = stack[:len(stack)-1]
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+func streamPathMatches(path, stack []string) bool {
+	if len(path) == 0 {
+		return len(stack) == 0
+	}
+	if path[0] == "**" {
+		for i := 0; i <= len(stack); i++ {
+			if streamPathMatches(path[1:], stack[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(stack) == 0 || (path[0] != "*" && path[0] != stack[0]) {
+		return false
+	}
+	return streamPathMatches(path[1:], stack[1:])
+}
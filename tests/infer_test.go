@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/infer"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfer(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	xmlFiles, err := filepath.Glob("testdata/infer/*.xml")
+	assert.Nil(t, err)
+	xsdPath := filepath.Join(dname, "library.xsd")
+	err = xsd2go.Infer(xmlFiles, xsdPath, infer.Options{MaxEnumValues: 5}, nil)
+	assert.Nil(t, err)
+
+	xsdText, err := ioutil.ReadFile(xsdPath)
+	assert.Nil(t, err)
+	assert.Contains(t, string(xsdText), `<xsd:element name="author" type="xsd:string" minOccurs="0" maxOccurs="unbounded"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:element name="published" type="xsd:date"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:element name="open" type="xsd:boolean"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:attribute name="status" type="library:BookStatusType" use="required"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:enumeration value="available"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:extension base="xsd:decimal">`)
+
+	err = xsd2go.Convert(xsdPath, "user.com/private", dname)
+	assert.Nil(t, err)
+}
+
+func TestInferEmptyValues(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	// Empty instance of an element that otherwise holds numbers makes it a string
+	xmlPath := filepath.Join(dname, "counts.xml")
+	err = ioutil.WriteFile(xmlPath, []byte(`<counts><x/><x>5</x><flag/></counts>`), 0644)
+	assert.Nil(t, err)
+	xsdPath := filepath.Join(dname, "counts.xsd")
+	err = xsd2go.Infer([]string{xmlPath}, xsdPath, infer.Options{}, nil)
+	assert.Nil(t, err)
+
+	xsdText, err := ioutil.ReadFile(xsdPath)
+	assert.Nil(t, err)
+	assert.Contains(t, string(xsdText), `<xsd:element name="x" type="xsd:string" maxOccurs="unbounded"/>`)
+	assert.Nil(t, xsd2go.Validate(xsdPath, []string{xmlPath}, xsd.WorkspaceOptions{}))
+}
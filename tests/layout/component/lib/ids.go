@@ -0,0 +1,130 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// xsd:ID and xsd:IDREF helpers for http://example.com/library
+package lib
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IDIndex maps xsd:ID values found in a document to the structs carrying them
+type IDIndex struct {
+	Nodes map[string]interface{}
+	refs  []string
+	errs  IDErrors
+}
+
+// IDError reports duplicate xsd:ID or dangling xsd:IDREF value
+type IDError struct {
+	Value   string
+	Problem string
+}
+
+func (e IDError) Error() string {
+	return fmt.Sprintf("%s '%s'", e.Problem, e.Value)
+}
+
+// IDErrors lists all problems found by ResolveIDs
+type IDErrors []IDError
+
+func (e IDErrors) Error() string {
+	var msgs []string
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Resolve returns struct carrying given xsd:ID
+func (idx *IDIndex) Resolve(id string) (interface{}, bool) {
+	node, ok := idx.Nodes[strings.TrimSpace(id)]
+	return node, ok
+}
+
+// ResolveAll returns structs referenced by whitespace separated xsd:IDREFS value
+func (idx *IDIndex) ResolveAll(idrefs string) ([]interface{}, bool) {
+	var res []interface{}
+	for _, id := range strings.Fields(idrefs) {
+		node, ok := idx.Nodes[id]
+		if !ok {
+			return nil, false
+		}
+		res = append(res, node)
+	}
+	return res, true
+}
+
+func (idx *IDIndex) addID(value string, node interface{}) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	if _, found := idx.Nodes[value]; found {
+		idx.errs = append(idx.errs, IDError{value, "duplicate xsd:ID"})
+		return
+	}
+	idx.Nodes[value] = node
+}
+
+func (idx *IDIndex) addIDREF(value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		idx.refs = append(idx.refs, value)
+	}
+}
+
+func (idx *IDIndex) addIDREFS(value string) {
+	idx.refs = append(idx.refs, strings.Fields(value)...)
+}
+
+func (idx *IDIndex) resolve() error {
+	for _, ref := range idx.refs {
+		if _, found := idx.Nodes[ref]; !found {
+			idx.errs = append(idx.errs, IDError{ref, "dangling xsd:IDREF"})
+		}
+	}
+	if len(idx.errs) > 0 {
+		return idx.errs
+	}
+	return nil
+}
+
+// ResolveIDs indexes all xsd:ID values of the library document and checks that every
+// xsd:IDREF and xsd:IDREFS value refers to one of them
+func (e *Library) ResolveIDs() (*IDIndex, error) {
+	idx := &IDIndex{Nodes: map[string]interface{}{}}
+	e.indexIDs(idx)
+	return idx, idx.resolve()
+}
+
+func (e *Library) indexIDs(idx *IDIndex) {
+	for i := range e.Author {
+		e.Author[i].indexIDs(idx)
+	}
+	for i := range e.Book {
+		e.Book[i].indexIDs(idx)
+	}
+}
+
+func (e *AuthorType) indexIDs(idx *IDIndex) {
+	idx.addID(e.Id, e)
+}
+
+// LookupAuthorType returns AuthorType carrying given xsd:ID
+func (idx *IDIndex) LookupAuthorType(id string) (*AuthorType, bool) {
+	node, ok := idx.Nodes[strings.TrimSpace(id)].(*AuthorType)
+	return node, ok
+}
+
+func (e *BookType) indexIDs(idx *IDIndex) {
+	idx.addID(e.Id, e)
+	idx.addIDREFS(e.Authors)
+	for _, value := range e.SeeAlso {
+		idx.addIDREF(value)
+	}
+}
+
+// LookupBookType returns BookType carrying given xsd:ID
+func (idx *IDIndex) LookupBookType(id string) (*BookType, bool) {
+	node, ok := idx.Nodes[strings.TrimSpace(id)].(*BookType)
+	return node, ok
+}
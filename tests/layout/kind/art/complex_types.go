@@ -0,0 +1,104 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Models for http://example.com/article
+package art
+
+import (
+	"encoding/xml"
+)
+
+// XSD ComplexType declarations
+
+type ParaType struct {
+	Id string `xml:"id,attr"`
+
+	Content []ParaTypeNode `xml:"-"`
+}
+
+// ParaTypeNode is either text or one of the child elements of ParaType mixed content.
+// Element holds child elements not declared by the schema.
+type ParaTypeNode struct {
+	Text    string
+	Emph    *string
+	Link    *LinkType
+	Element *MixedElement
+}
+
+// UnmarshalXML decodes ParaType keeping order of text and child elements
+func (v *ParaType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "id":
+			v.Id = attr.Value
+		}
+	}
+	lastText := false
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			if lastText {
+				v.Content[len(v.Content)-1].Text += string(t)
+			} else {
+				v.Content = append(v.Content, ParaTypeNode{Text: string(t)})
+			}
+			lastText = true
+		case xml.StartElement:
+			var node ParaTypeNode
+			switch t.Name.Local {
+			case "emph":
+				node.Emph = new(string)
+				if err := d.DecodeElement(node.Emph, &t); err != nil {
+					return err
+				}
+			case "link":
+				node.Link = new(LinkType)
+				if err := d.DecodeElement(node.Link, &t); err != nil {
+					return err
+				}
+			default:
+				node.Element = &MixedElement{}
+				if err := node.Element.UnmarshalXML(d, t); err != nil {
+					return err
+				}
+			}
+			v.Content = append(v.Content, node)
+			lastText = false
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML encodes ParaType content nodes in order
+func (v ParaType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "id"}, Value: v.Id})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, node := range v.Content {
+		var err error
+		switch {
+		case node.Emph != nil:
+			err = e.EncodeElement(node.Emph, xml.StartElement{Name: xml.Name{Local: "emph"}})
+		case node.Link != nil:
+			err = e.EncodeElement(node.Link, xml.StartElement{Name: xml.Name{Local: "link"}})
+		case node.Element != nil:
+			err = node.Element.MarshalXML(e, xml.StartElement{})
+		default:
+			err = e.EncodeToken(xml.CharData(node.Text))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+type LinkType struct {
+	Target string `xml:"target,attr"`
+
+	Text string `xml:",chardata"`
+}
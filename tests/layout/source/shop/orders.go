@@ -0,0 +1,23 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// Models for http://example.com/orders
+package shop
+
+import (
+	"encoding/xml"
+)
+
+// Element
+type Orders struct {
+	XMLName xml.Name `xml:"orders"`
+
+	Order []OrdersOrder `xml:"order"`
+}
+
+// Element
+type OrdersOrder struct {
+	XMLName xml.Name `xml:"order"`
+
+	Id string `xml:"id,attr"`
+
+	Customer CustomerType `xml:"customer"`
+}
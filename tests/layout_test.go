@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/layout/source/shop"
+	"github.com/stretchr/testify/assert"
+)
+
+// Packages under layout/ are generated with --layout set to the directory name
+func TestLayoutPackagesUpToDate(t *testing.T) {
+	assertGeneratedUpToDate(t, "testdata/ids/library.xsd", "layout/component", template.Options{Layout: template.LayoutComponent})
+	assertGeneratedUpToDate(t, "testdata/mixed/article.xsd", "layout/kind", template.Options{Layout: template.LayoutKind, MixedContent: template.MixedContentNodes})
+	assertGeneratedUpToDate(t, "testdata/layout/orders.xsd", "layout/source", template.Options{Layout: template.LayoutSource})
+}
+
+func TestLayoutSharedPackage(t *testing.T) {
+	// Schemas orders.xsd and customers.xsd share go package, each gets its own file
+	data, err := xml.Marshal(shop.Orders{Order: []shop.OrdersOrder{{Id: "1", Customer: shop.CustomerType{Name: "Ann"}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, `<orders><order id="1"><customer vip=""><name>Ann</name></customer></order></orders>`, string(data))
+
+	// Conflict is detected before anything is written
+	sink := memorySink{}
+	_, err = xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/layout/orders.xsd", Sink: sink})
+	assert.EqualError(t, err, "Several schemas generate 'shop/models.go', consider using source layout")
+	assert.Empty(t, sink)
+
+	// Helper files are named after the source XSD just like the structs
+	opts := template.Options{Layout: template.LayoutSource, XMLCodecs: true}
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/layout/orders.xsd", Template: opts})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"shop/orders_codec.go", "shop/orders_stream.go", "shop/orders.go", "shop/customers_codec.go", "shop/customers.go"}, res.Paths())
+	assertVetClean(t, xsd2go.Options{XSDPath: "testdata/layout/orders.xsd", Template: opts})
+}
+
+func TestUnknownLayout(t *testing.T) {
+	_, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:  "testdata/layout/orders.xsd",
+		Template: template.Options{Layout: "pages"},
+	})
+	assert.EqualError(t, err, "Unknown layout 'pages', expected one of: single, component, source, kind")
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/tests/mixed/art"
+	"github.com/stretchr/testify/assert"
+)
+
+// Package mixed/art is generated from testdata/mixed/article.xsd with --mixed-content nodes
+func TestMixedNodesUpToDate(t *testing.T) {
+	assertGeneratedUpToDate(t, "testdata/mixed/article.xsd", "mixed", template.Options{MixedContent: template.MixedContentNodes})
+}
+
+func TestMixedNodes(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/mixed/article.xml")
+	assert.Nil(t, err)
+
+	var article art.Article
+	assert.Nil(t, xml.Unmarshal(data, &article))
+
+	content := article.Para[0].Content
+	assert.Len(t, content, 7)
+	assert.Equal(t, "Read ", content[0].Text)
+	assert.Equal(t, "this", *content[1].Emph)
+	assert.Equal(t, " and ", content[2].Text)
+	assert.Equal(t, "p2", content[3].Link.Target)
+	assert.Equal(t, "that", content[3].Link.Text)
+	assert.Equal(t, "http://www.w3.org/1999/xhtml", content[5].Element.XMLName.Space)
+	assert.Equal(t, "b", content[5].Element.XMLName.Local)
+	assert.Equal(t, "i", content[5].Element.Content[1].Element.XMLName.Local)
+	assert.Equal(t, ".", content[6].Text)
+
+	idx, err := article.ResolveIDs()
+	assert.Nil(t, err)
+	_, ok := idx.LookupParaType("p2")
+	assert.True(t, ok)
+
+	content[1].Emph = nil
+	content[1].Text = "all of "
+	out, err := xml.Marshal(article)
+	assert.Nil(t, err)
+	assert.Equal(t, `<article><title>Mixed</title><para id="p1">Read all of  and <link target="p2">that</link>, `+
+		`<b xmlns="http://www.w3.org/1999/xhtml" class="x">bold <i xmlns="http://www.w3.org/1999/xhtml">text</i></b>.</para>`+
+		`<para id="p2">Second</para></article>`, string(out))
+}
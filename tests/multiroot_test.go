@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultipleRoots(t *testing.T) {
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPaths: []string{
+			"testdata/deterministic/*.xsd",
+			"testdata/nsimport/lib",
+			// Already loaded through the glob
+			"testdata/nsimport/../deterministic/address.xsd",
+		},
+		GoModule: "example.com/multi",
+	})
+	assert.Nil(t, err)
+	var loaded []string
+	for _, sch := range res.Workspace.Schemas() {
+		loaded = append(loaded, sch.TargetNamespace)
+	}
+	assert.Equal(t, []string{
+		"http://example.com/address",
+		"http://example.com/main",
+		"http://example.com/payment",
+		"http://example.com/colors",
+		"http://example.com/units",
+	}, loaded)
+	assert.Equal(t, []string{"address/models.go", "main/models.go", "main/stream.go", "payment/models.go", "unit/models.go"}, sortedKeys(toStrings(res.Files)))
+}
+
+func TestMultipleRootsNoMatch(t *testing.T) {
+	_, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPaths: []string{"testdata/deterministic/main.xsd", "testdata/missing/*.xsd"},
+	})
+	assert.EqualError(t, err, "No XSD files match 'testdata/missing/*.xsd'")
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceImportFromSchemaDir(t *testing.T) {
+	var logs bytes.Buffer
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:    "testdata/nsimport/measure.xsd",
+		GoModule:   "example.com/nsimport",
+		SchemaDirs: []string{"testdata/nsimport/lib"},
+		Logger:     xsd.NewLogger(&logs, xsd.LevelVerbose),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ms/models.go", "unit/models.go"}, sortedKeys(toStrings(res.Files)))
+	assertVetClean(t, xsd2go.Options{XSDPath: "testdata/nsimport/measure.xsd", SchemaDirs: []string{"testdata/nsimport/lib"}})
+	assert.Contains(t, logs.String(), "\tNamespace 'http://example.com/units' found in schema directory as testdata/nsimport/lib/units.xsd\n")
+	assert.Contains(t, logs.String(), "\tImport of namespace 'http://example.com/unused' in testdata/nsimport/measure.xsd has no schemaLocation nor matching schema, skipped\n")
+}
+
+func TestNamespaceImportOfLoadedSchema(t *testing.T) {
+	var logs bytes.Buffer
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath: "testdata/nsimport/lab.xsd",
+		Logger:  xsd.NewLogger(&logs, xsd.LevelVerbose),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Workspace.Schemas(), 3)
+	assert.Contains(t, logs.String(), "\tImport of namespace 'http://example.com/units' in testdata/nsimport/measure.xsd satisfied by already loaded testdata/nsimport/lib/units.xsd\n")
+}
+
+func TestNamespaceImportUnresolved(t *testing.T) {
+	_, err := xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/nsimport/measure.xsd"})
+	assert.EqualError(t, err, "Cannot resolve namespace 'http://example.com/units' imported by testdata/nsimport/measure.xsd without schemaLocation: no loaded schema, XML catalog entry nor schema in schema directories declares it")
+}
+
+func TestNamespaceImportAfterFailedLoad(t *testing.T) {
+	ws, err := xsd.NewWorkspaceWithOptions(xsd.WorkspaceOptions{})
+	assert.Nil(t, err)
+	_, err = ws.Load("testdata/nsimport/measure.xsd")
+	assert.EqualError(t, err, "Cannot resolve namespace 'http://example.com/units' imported by testdata/nsimport/measure.xsd without schemaLocation: no loaded schema, XML catalog entry nor schema in schema directories declares it")
+	assert.Empty(t, ws.Schemas())
+	assert.Nil(t, ws.Schema("testdata/nsimport/measure.xsd"))
+
+	// Schema that failed to compile is loaded again, now that the imported namespace is known
+	_, err = ws.Load("testdata/nsimport/lab.xsd")
+	assert.Nil(t, err)
+	assert.Len(t, ws.Schemas(), 3)
+	errs, err := ws.Validate(strings.NewReader(`<lab xmlns="http://example.com/lab" xmlns:ms="http://example.com/measure">
+  <ms:measurement><ms:length unit="cm">long</ms:length></ms:measurement>
+</lab>`))
+	assert.Nil(t, err)
+	assert.Equal(t, []xsd.ValidationError{{Line: 2, Column: 19, Message: "element length: value 'long' is not a valid xsd:decimal"}}, errs)
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/redefine/addr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedefine(t *testing.T) {
+	// Package redefine/addr is generated from extended.xsd redefining base.xsd
+	assertGeneratedUpToDate(t, "testdata/redefine/extended.xsd", "redefine", template.Options{})
+	// Redefinition extends the original address
+	data, err := ioutil.ReadFile("testdata/redefine/person.xml")
+	assert.Nil(t, err)
+	var person addr.Person
+	assert.Nil(t, xml.Unmarshal(data, &person))
+	assert.Equal(t, addr.Address{Country: "CZ", Street: "Main 1", City: "Brno", Zip: "60200"}, person.Address)
+
+	assert.Nil(t, xsd2go.Validate("testdata/redefine/extended.xsd", []string{"testdata/redefine/person.xml"}, xsd.WorkspaceOptions{}))
+	err = xsd2go.Validate("testdata/redefine/extended.xsd", []string{"testdata/redefine/person-invalid.xml"}, xsd.WorkspaceOptions{})
+	assert.EqualError(t, err, "1 of 1 documents failed validation")
+}
+
+func TestOverride(t *testing.T) {
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{XSDPath: "testdata/redefine/override.xsd"})
+	assert.Nil(t, err)
+	models := string(res.Files["addr/models.go"])
+	assert.Contains(t, models, "FullName string `xml:\"full-name\"`")
+	assert.Contains(t, models, "Postcode string `xml:\"postcode\"`")
+	assert.NotContains(t, models, "Street")
+}
+
+func TestRedefineUnknownType(t *testing.T) {
+	fsys := fstest.MapFS{
+		"base.xsd": &fstest.MapFile{Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:base"/>`)},
+		"main.xsd": &fstest.MapFile{Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:base">
+  <xs:redefine schemaLocation="base.xsd"><xs:complexType name="missing"/></xs:redefine>
+</xs:schema>`)},
+	}
+	_, err := xsd2go.Generate(context.Background(), xsd2go.Options{FS: fsys, XSDPath: "main.xsd"})
+	assert.EqualError(t, err, "xsd:redefine in main.xsd redefines complexType 'missing' not declared in base.xsd")
+}
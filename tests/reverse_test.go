@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/reverse"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	xsdPath := filepath.Join(dname, "library.xsd")
+	opts := reverse.Options{TargetNamespace: "http://example.com/library"}
+	err = xsd2go.Reverse("testdata/reverse", xsdPath, opts, nil)
+	assert.Nil(t, err)
+
+	xsdText, err := ioutil.ReadFile(xsdPath)
+	assert.Nil(t, err)
+	assert.Contains(t, string(xsdText), `<xsd:element name="library" type="library:Library"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:element name="book" type="library:Book" minOccurs="0" maxOccurs="unbounded"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:attribute name="status" type="xsd:string"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:extension base="xsd:string">`)
+	assert.Contains(t, string(xsdText), `<xsd:element name="pages" type="xsd:long"/>`)
+	// Types declared in other packages
+	assert.Contains(t, string(xsdText), `<xsd:attribute name="isbn" type="xsd:string">`)
+	assert.Contains(t, string(xsdText), `<xsd:element name="loan" type="library:Period" minOccurs="0" maxOccurs="unbounded"/>`)
+	assert.Contains(t, string(xsdText), `<xsd:attribute name="until" type="xsd:dateTime"/>`)
+
+	// Generated schema can be fed back to xsd2go
+	err = xsd2go.Convert(xsdPath, "user.com/private", dname)
+	assert.Nil(t, err)
+	models, err := ioutil.ReadFile(filepath.Join(dname, "library", "models.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(models), "Book []Book `xml:\"book\"`")
+}
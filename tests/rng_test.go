@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/rng/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRNGGenerated(t *testing.T) {
+	// Package rng/lib is generated from library.rng, library.rnc is the same grammar
+	// in compact syntax
+	for _, grammar := range []string{"testdata/rng/library.rng", "testdata/rng/library.rnc"} {
+		res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+			RNGPath:   grammar,
+			GoModule:  "github.com/gocomply/xsd2go",
+			OutputDir: "tests/rng",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"lib/ids.go", "lib/stream.go", "lib/models.go"}, res.Paths())
+		for _, path := range res.Paths() {
+			expected, err := ioutil.ReadFile(filepath.Join("rng", path))
+			assert.Nil(t, err)
+			assert.Equal(t, string(expected), string(res.Files[path]), "rng/%s is out of date or differs for %s", path, grammar)
+		}
+
+		assert.Empty(t, validateFile(t, res.Workspace, "testdata/rng/library.xml"))
+		messages := []string{}
+		for _, e := range validateFile(t, res.Workspace, "testdata/rng/library-invalid.xml") {
+			messages = append(messages, e.Error())
+		}
+		assert.Equal(t, []string{
+			"2:1: attribute version on element library must have fixed value '1.0'",
+			"3:3: attribute format: value 'audiobook' is not one of the enumerated values",
+			"4:5: unexpected element author in book, expected: title",
+		}, messages, grammar)
+	}
+
+	data, err := ioutil.ReadFile("testdata/rng/library.xml")
+	assert.Nil(t, err)
+	var doc lib.Library
+	assert.Nil(t, xml.Unmarshal(data, &doc))
+	assert.Len(t, doc.Book, 1)
+	assert.Equal(t, "en", doc.Book[0].Title.Lang)
+	assert.Equal(t, "Kernighan", doc.Book[0].Author[1].Name.Last.Text)
+	assert.Equal(t, "USD", doc.Book[0].Price.Currency)
+	assert.Equal(t, "4.5", doc.Book[0].Tags.Rating.Text)
+	assert.Equal(t, "Jane Reader", doc.Member[0].Name.Text)
+	_, err = doc.ResolveIDs()
+	assert.Nil(t, err)
+}
+
+func TestRNGErrors(t *testing.T) {
+	for grammar, expected := range map[string]string{
+		`start = element a { b }`:                                            "Reference to undefined b in RELAX NG grammar doc.rnc",
+		`start = a  a = element a { text }  a = element b { text }`:          "Pattern a is defined more than once without combine in doc.rnc",
+		`start = element a { element x:b { text } }`:                         "Error parsing RELAX NG grammar doc.rnc: line 1: undeclared namespace prefix x",
+		`namespace x = "urn:x" start = element a { element x:b { text } }`:   "RELAX NG grammar doc.rnc declares elements of several namespaces (, urn:x), only single namespace is supported",
+		`include "http://example.com/common.rnc" start = element a { text }`: "Error parsing RELAX NG grammar doc.rnc: line 1: Cannot load http://example.com/common.rnc referred to by doc.rnc, only local files are supported",
+		`start = a  a = element a { b }  b = empty | b`:                      "Define b of RELAX NG grammar doc.rnc refers to itself outside of element",
+	} {
+		_, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+			FS:      fstest.MapFS{"doc.rnc": &fstest.MapFile{Data: []byte(grammar)}},
+			RNGPath: "doc.rnc",
+		})
+		assert.EqualError(t, err, expected, grammar)
+	}
+}
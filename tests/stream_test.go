@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/ids/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamableElements(t *testing.T) {
+	ws, err := xsd.NewWorkspace("", "testdata/ids/library.xsd")
+	assert.Nil(t, err)
+
+	var streams []string
+	for _, se := range ws.Schema("testdata/ids/library.xsd").StreamableElements() {
+		streams = append(streams, se.GoStreamName()+" "+se.GoTypeName()+" "+filepath.Join(se.Path...))
+	}
+	assert.Equal(t, []string{
+		"StreamAuthors AuthorType library/author",
+		"StreamBooks BookType library/book",
+	}, streams)
+
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	err = xsd2go.Convert("testdata/ids/library.xsd", "user.com/private", dname)
+	assert.Nil(t, err)
+
+	stream, err := ioutil.ReadFile(filepath.Join(dname, "lib", "stream.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(stream), "func StreamBooks(ctx context.Context, r io.Reader, fn func(*BookType) error, path ...string) error {")
+	assert.Contains(t, string(stream), `path = []string{"library", "book"}`)
+}
+
+const archiveXML = `<archive xmlns="http://example.com/library">
+  <shelf><book id="b3"><title>Third</title></book></shelf>
+  <book id="b4"><title>Fourth</title></book>
+</archive>`
+
+func TestStreamBooks(t *testing.T) {
+	// Package tests/ids/lib is kept up to date by TestResolveIDs
+	f, err := os.Open("testdata/ids/library.xml")
+	assert.Nil(t, err)
+	defer f.Close()
+	assert.Equal(t, []string{"b1", "b2"}, streamBookIds(t, context.Background(), f))
+
+	assert.Equal(t, []string{"b4"}, streamBookIds(t, context.Background(), strings.NewReader(archiveXML), "*", "book"))
+	assert.Equal(t, []string{"b3"}, streamBookIds(t, context.Background(), strings.NewReader(archiveXML), "archive", "*", "book"))
+	assert.Equal(t, []string{"b3", "b4"}, streamBookIds(t, context.Background(), strings.NewReader(archiveXML), "**", "book"))
+	assert.Empty(t, streamBookIds(t, context.Background(), strings.NewReader(archiveXML)))
+
+	// Error of fn stops streaming and is returned as is
+	errStop := errors.New("stop")
+	var seen []string
+	err = lib.StreamBooks(context.Background(), strings.NewReader(archiveXML), func(b *lib.BookType) error {
+		seen = append(seen, b.Id)
+		return errStop
+	}, "**", "book")
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"b3"}, seen)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	seen = nil
+	err = lib.StreamBooks(ctx, strings.NewReader(archiveXML), func(b *lib.BookType) error {
+		seen = append(seen, b.Id)
+		cancel()
+		return nil
+	}, "**", "book")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"b3"}, seen)
+}
+
+func streamBookIds(t *testing.T, ctx context.Context, r io.Reader, path ...string) []string {
+	var ids []string
+	err := lib.StreamBooks(ctx, r, func(b *lib.BookType) error {
+		ids = append(ids, b.Id)
+		return nil
+	}, path...)
+	assert.Nil(t, err)
+	return ids
+}
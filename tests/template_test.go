@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateDir(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	opts := template.Options{TemplateDir: "templates"}
+	err = xsd2go.ConvertWithOptions("xsd-examples/valid/simple.xsd", "user.com/private", dname, opts)
+	assert.Nil(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dname, "simple_schema", "*"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"models.go", "validate.go"}, baseNames(files))
+
+	validate, err := ioutil.ReadFile(filepath.Join(dname, "simple_schema", "validate.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(validate), "// Validate checks Myelement (myelement)\nfunc (e *Myelement) Validate() error")
+}
+
+func baseNames(paths []string) []string {
+	var res []string
+	for _, path := range paths {
+		res = append(res, filepath.Base(path))
+	}
+	return res
+}
+
+func TestJSONTags(t *testing.T) {
+	dname, err := ioutil.TempDir("", "xsd2go_tests_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dname)
+
+	opts := template.Options{JSONTags: true, TagNaming: template.TagNamingSnake}
+	err = xsd2go.ConvertWithOptions("xsd-examples/valid/simple.xsd", "user.com/private", dname, opts)
+	assert.Nil(t, err)
+
+	models, err := ioutil.ReadFile(filepath.Join(dname, "simple_schema", "models.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(models), "XMLName xml.Name `xml:\"myelement\" json:\"-\"`")
+	assert.Contains(t, string(models), "Id int64 `xml:\"id\" json:\"id\"`")
+
+	opts.TagNaming = "pascal"
+	err = xsd2go.ConvertWithOptions("xsd-examples/valid/simple.xsd", "user.com/private", dname, opts)
+	assert.NotNil(t, err)
+}
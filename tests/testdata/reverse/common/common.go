@@ -0,0 +1,14 @@
+// Package common holds types shared by the library fixture, it exercises reverse
+// conversion of types declared in other packages
+package common
+
+import "time"
+
+// ISBN identifies book edition
+type ISBN string
+
+type Period struct {
+	From time.Time `xml:"from,attr"`
+	// Until is missing while the book is still loaned
+	Until *time.Time `xml:"until,attr"`
+}
@@ -0,0 +1,41 @@
+package library
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/gocomply/xsd2go/tests/testdata/reverse/common"
+)
+
+// Library is the root of the document
+type Library struct {
+	XMLName xml.Name   `xml:"library"`
+	Name    string     `xml:"name,attr"`
+	Books   []Book     `xml:"book"`
+	Updated *time.Time `xml:"updated"`
+}
+
+type Base struct {
+	ID string `xml:"id,attr"`
+}
+
+type Status string
+
+// Book in the library
+type Book struct {
+	Base
+	// Title of the book
+	Title  string `xml:"title"`
+	Status Status `xml:"status,attr,omitempty"`
+	Note   *Note  `xml:"note,omitempty"`
+	Pages  int    `xml:"pages"`
+	// ISBN of the edition the library holds
+	ISBN   common.ISBN     `xml:"isbn,attr,omitempty"`
+	Loans  []common.Period `xml:"loan"`
+	secret string
+}
+
+type Note struct {
+	Lang string `xml:"lang,attr"`
+	Text string `xml:",chardata"`
+}
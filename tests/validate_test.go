@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	ws, err := xsd.NewWorkspace("", "testdata/validate/orders.xsd")
+	assert.Nil(t, err)
+
+	errs := validateFile(t, ws, "testdata/validate/valid.xml")
+	assert.Empty(t, errs)
+
+	errs = validateFile(t, ws, "testdata/validate/invalid.xml")
+	messages := []string{}
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	assert.Equal(t, []string{
+		"3:3: attribute status: value 'lost' is not one of the enumerated values",
+		"3:3: required attribute id missing on element order",
+		"5:5: unexpected element item in order, expected: email, phone",
+		"7:3: attribute color is not allowed on element order",
+		"9:5: element phone: value '555' is shorter than 5",
+		"10:5: element item: value '100' must be less than 100",
+		"11:5: element tags: value '42' is not a valid xsd:NCName",
+		"19:5: unexpected element item in order, expected: tags, any element",
+	}, messages)
+}
+
+func validateFile(t *testing.T, ws *xsd.Workspace, xmlPath string) []xsd.ValidationError {
+	f, err := os.Open(xmlPath)
+	assert.Nil(t, err)
+	defer f.Close()
+
+	errs, err := ws.Validate(f)
+	assert.Nil(t, err)
+	return errs
+}
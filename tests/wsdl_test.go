@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/wsdl/common"
+	"github.com/gocomply/xsd2go/tests/wsdl/quote"
+	"github.com/gocomply/xsd2go/tests/wsdl/stockquoteservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWSDLGenerated(t *testing.T) {
+	// Package wsdl/* is generated from stockquote.wsdl
+	res, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		WSDLPath:  "testdata/wsdl/stockquote.wsdl",
+		GoModule:  "github.com/gocomply/xsd2go",
+		OutputDir: "tests/wsdl",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"common/models.go", "quote/models.go", "stockquoteservice/client.go"}, res.Paths())
+	for _, path := range res.Paths() {
+		expected, err := ioutil.ReadFile(filepath.Join("wsdl", path))
+		assert.Nil(t, err)
+		assert.Equal(t, string(expected), string(res.Files[path]), "wsdl/%s is out of date, re-generate it", path)
+	}
+}
+
+// soapServer answers every request with given status and body, the last request is recorded
+func soapServer(t *testing.T, status int, body string) (*httptest.Server, *http.Request, *string) {
+	var last http.Request
+	var lastBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		last, lastBody = *r, string(data)
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	return srv, &last, &lastBody
+}
+
+func TestWSDLClientSOAP11(t *testing.T) {
+	srv, req, reqBody := soapServer(t, http.StatusOK, `<?xml version="1.0"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <q:GetQuoteResponse xmlns:q="http://example.com/stockquote/quote">
+      <q:Symbol>ACME</q:Symbol>
+      <q:Price currency="USD">12.50</q:Price>
+    </q:GetQuoteResponse>
+  </soap:Body>
+</soap:Envelope>`)
+	defer srv.Close()
+
+	client := stockquoteservice.NewStockQuoteSoapClient(srv.Client())
+	assert.Equal(t, "http://example.com/stockquote", client.URL)
+	client.URL = srv.URL
+	resp, err := client.GetQuote(context.Background(), &quote.GetQuote{Symbol: "ACME"})
+	assert.Nil(t, err)
+	assert.Equal(t, "ACME", resp.Symbol)
+	assert.Equal(t, common.Price{Currency: "USD", Text: "12.50"}, resp.Price)
+
+	assert.Equal(t, `"http://example.com/stockquote/GetQuote"`, req.Header.Get("SOAPAction"))
+	assert.Equal(t, "text/xml; charset=utf-8", req.Header.Get("Content-Type"))
+	assert.Equal(t, `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>`+
+		`<GetQuote xmlns="http://example.com/stockquote/quote"><Symbol>ACME</Symbol></GetQuote>`+
+		`</soap:Body></soap:Envelope>`, *reqBody)
+}
+
+func TestWSDLClientSOAP12Fault(t *testing.T) {
+	srv, req, _ := soapServer(t, http.StatusInternalServerError, `<?xml version="1.0"?>
+<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope">
+  <env:Body>
+    <env:Fault>
+      <env:Code><env:Value>env:Sender</env:Value></env:Code>
+      <env:Reason><env:Text xml:lang="en">Unknown symbol</env:Text></env:Reason>
+      <env:Detail><c:UnknownSymbol xmlns:c="http://example.com/stockquote/common" symbol="XXX"/></env:Detail>
+    </env:Fault>
+  </env:Body>
+</env:Envelope>`)
+	defer srv.Close()
+
+	client := &stockquoteservice.StockQuoteSoap12Client{URL: srv.URL}
+	_, err := client.GetQuote(context.Background(), &quote.GetQuote{Symbol: "XXX"})
+	assert.EqualError(t, err, "SOAP fault env:Sender: Unknown symbol")
+	fault, ok := err.(*stockquoteservice.SOAPFault)
+	assert.True(t, ok)
+	assert.Contains(t, fault.Detail, `symbol="XXX"`)
+
+	assert.Equal(t, "", req.Header.Get("SOAPAction"))
+	assert.Equal(t, `application/soap+xml; charset=utf-8; action="http://example.com/stockquote/GetQuote"`, req.Header.Get("Content-Type"))
+}
+
+func TestWSDLClientOneWay(t *testing.T) {
+	srv, _, reqBody := soapServer(t, http.StatusAccepted, "")
+	defer srv.Close()
+
+	client := &stockquoteservice.StockQuoteSoapClient{URL: srv.URL}
+	err := client.Subscribe(context.Background(), &quote.Subscribe{Symbol: []string{"ACME", "INIT"}, Callback: "http://localhost/quotes"})
+	assert.Nil(t, err)
+	assert.Contains(t, *reqBody, "<Symbol>ACME</Symbol><Symbol>INIT</Symbol>")
+
+	srv, _, _ = soapServer(t, http.StatusNotFound, "")
+	defer srv.Close()
+	client.URL = srv.URL
+	err = client.Subscribe(context.Background(), &quote.Subscribe{})
+	assert.EqualError(t, err, "SOAP request to "+srv.URL+" failed: 404 Not Found")
+}
+
+func TestWSDLClientRPC(t *testing.T) {
+	srv, _, reqBody := soapServer(t, http.StatusOK, `<?xml version="1.0"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <m:GetLastTradeResponse xmlns:m="http://example.com/stockquote/trade">
+      <price currency="EUR">9.95</price>
+      <volume>1200</volume>
+    </m:GetLastTradeResponse>
+  </soap:Body>
+</soap:Envelope>`)
+	defer srv.Close()
+
+	client := &stockquoteservice.TradeSoapClient{URL: srv.URL}
+	resp, err := client.GetLastTrade(context.Background(), &stockquoteservice.GetLastTradeRequest{Symbol: "ACME"})
+	assert.Nil(t, err)
+	assert.Equal(t, &stockquoteservice.GetLastTradeResponse{Price: common.Price{Currency: "EUR", Text: "9.95"}, Volume: 1200}, resp)
+	assert.Contains(t, *reqBody, `<m:GetLastTrade xmlns:m="http://example.com/stockquote/trade"><symbol>ACME</symbol></m:GetLastTrade>`)
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/xpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXPathSubset(t *testing.T) {
+	doc, err := xpath.Parse(strings.NewReader(`<order xmlns:x="urn:x" min="2" max="10" currency="EUR">
+  <item qty="3" price="1.5">pen</item>
+  <item qty="4" price="2">ink</item>
+  <x:note>fragile</x:note>
+  <start>2024-01-01</start><end>2024-02-01</end>
+</order>`))
+	assert.Nil(t, err)
+	ctx := &xpath.Context{Node: doc, Namespaces: map[string]string{"x": "urn:x"}}
+	for test, expected := range map[string]bool{
+		"@min le @max":                              true,
+		"@min < @max and @currency = 'EUR'":         true,
+		"count(item) = 2":                           true,
+		"sum(item/@qty) > 6":                        true,
+		"item[2] = 'ink'":                           true,
+		"item[@qty > 3]/@price = 2":                 true,
+		"exists(x:note) and not(note)":              true,
+		"empty(@discount)":                          true,
+		"xs:date(start) lt xs:date(end)":            true,
+		"string-length(x:note) = 7":                 true,
+		".//item[1]/@qty * 2 = 6":                   true,
+		"@max - @min = 8 or @missing":               true,
+		"(item/@qty, 7)[last()] = 7":                true,
+		"starts-with(item[1], 'p')":                 true,
+		"@min != 2":                                 false,
+		"count(*) = 5 and count(item | x:note) = 3": true,
+	} {
+		expr, err := xpath.Compile(test)
+		assert.Nil(t, err, test)
+		if err != nil {
+			continue
+		}
+		res, err := expr.Bool(ctx)
+		assert.Nil(t, err, test)
+		assert.Equal(t, expected, res, test)
+	}
+
+	value := " 42 "
+	expr, err := xpath.Compile("$value mod 2 = 0 and $value > 40")
+	assert.Nil(t, err)
+	res, err := expr.Bool(&xpath.Context{Value: &value})
+	assert.Nil(t, err)
+	assert.True(t, res)
+
+	_, err = xpath.Compile("@a = ")
+	assert.EqualError(t, err, "Cannot parse XPath '@a = ': unexpected end of expression")
+	expr, err = xpath.Compile("unknown(@a)")
+	assert.Nil(t, err)
+	_, err = expr.Bool(ctx)
+	assert.EqualError(t, err, "Cannot evaluate XPath 'unknown(@a)': unknown function unknown()")
+}
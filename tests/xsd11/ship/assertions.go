@@ -0,0 +1,72 @@
+// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
+// XSD 1.1 assertions and type alternatives for http://example.com/xsd11/shipment
+package ship
+
+import (
+	"fmt"
+)
+
+// XPathEvaluator evaluates XPath test of xsd:assert or xsd:alternative with the value as
+// context item, github.com/gocomply/xsd2go/pkg/xpath.Evaluator is one such evaluator
+type XPathEvaluator interface {
+	Test(value interface{}, test string) (bool, error)
+}
+
+// AssertionError reports xsd:assert the value does not satisfy
+type AssertionError struct {
+	Type string
+	Test string
+}
+
+func (e AssertionError) Error() string {
+	return fmt.Sprintf("%s does not satisfy assertion %s", e.Type, e.Test)
+}
+
+func checkAssertions(ev XPathEvaluator, value interface{}, typeName string, tests []string) error {
+	for _, test := range tests {
+		ok, err := ev.Test(value, test)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return AssertionError{typeName, test}
+		}
+	}
+	return nil
+}
+
+// CheckAssertions evaluates xsd:assert of shipment, nested structs are not checked
+func (e *Shipment) CheckAssertions(ev XPathEvaluator) error {
+	return checkAssertions(ev, e, "shipment", []string{
+		"count(ship:parcel) le 3",
+	})
+}
+
+// TypeAlternative returns name of the go type selected for payment by its xsd:alternatives
+func (e *Payment) TypeAlternative(ev XPathEvaluator) (string, error) {
+	if ok, err := ev.Test(e, "@method = 'card'"); err != nil {
+		return "", err
+	} else if ok {
+		return "CardPayment", nil
+	}
+	if ok, err := ev.Test(e, "@method = 'invoice'"); err != nil {
+		return "", err
+	} else if ok {
+		return "InvoicePayment", nil
+	}
+	return "Payment", nil
+}
+
+// CheckAssertions evaluates xsd:assert of window, nested structs are not checked
+func (t *Window) CheckAssertions(ev XPathEvaluator) error {
+	return checkAssertions(ev, t, "window", []string{
+		"xs:date(@from) le xs:date(@to)",
+	})
+}
+
+// CheckAssertions evaluates xsd:assert of invoicePayment, nested structs are not checked
+func (t *InvoicePayment) CheckAssertions(ev XPathEvaluator) error {
+	return checkAssertions(ev, t, "invoicePayment", []string{
+		"@amount gt 0",
+	})
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gocomply/xsd2go/pkg/template"
+	"github.com/gocomply/xsd2go/pkg/xpath"
+	"github.com/gocomply/xsd2go/pkg/xsd"
+	"github.com/gocomply/xsd2go/pkg/xsd2go"
+	"github.com/gocomply/xsd2go/tests/xsd11/ship"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXSD11Validate(t *testing.T) {
+	ws, err := xsd.NewWorkspace("", "testdata/xsd11/shipment.xsd")
+	assert.Nil(t, err)
+
+	assert.Empty(t, validateFile(t, ws, "testdata/xsd11/shipment.xml"))
+
+	messages := []string{}
+	for _, e := range validateFile(t, ws, "testdata/xsd11/shipment-invalid.xml") {
+		messages = append(messages, e.Error())
+	}
+	assert.Equal(t, []string{
+		"2:1: attribute id: value 'XX0042' does not satisfy assertion string-length($value) eq 6 and starts-with($value, 'SH')",
+		"2:1: element shipment does not satisfy assertion count(ship:parcel) le 3",
+		"3:3: element window does not satisfy assertion xs:date(@from) le xs:date(@to)",
+		"6:5: unexpected element colour in parcel, expected: fragile",
+		"12:5: unexpected element due in payment, expected: card",
+	}, messages)
+}
+
+func TestXSD11Generated(t *testing.T) {
+	// Package xsd11/ship is generated from shipment.xsd
+	assertGeneratedUpToDate(t, "testdata/xsd11/shipment.xsd", "xsd11", template.Options{})
+
+	data, err := ioutil.ReadFile("testdata/xsd11/shipment.xml")
+	assert.Nil(t, err)
+	var shipment ship.Shipment
+	assert.Nil(t, xml.Unmarshal(data, &shipment))
+	// Undeclared elements accepted by open content are kept
+	assert.Len(t, shipment.Parcel[0].OpenContent, 2)
+	assert.Equal(t, xml.Name{Space: "urn:extra", Local: "barcode"}, shipment.Parcel[0].OpenContent[1].XMLName)
+	assert.Equal(t, "signature", shipment.Note.OpenContent[0].XMLName.Local)
+
+	ev := &xpath.Evaluator{}
+	assert.Nil(t, shipment.CheckAssertions(ev))
+	assert.Nil(t, shipment.Window.CheckAssertions(ev))
+	shipment.Window.To = "2024-02-01"
+	assert.EqualError(t, shipment.Window.CheckAssertions(ev), "window does not satisfy assertion xs:date(@from) le xs:date(@to)")
+
+	typ, err := shipment.Payment.TypeAlternative(ev)
+	assert.Nil(t, err)
+	assert.Equal(t, "InvoicePayment", typ)
+	shipment.Payment.Method = "cash"
+	typ, err = shipment.Payment.TypeAlternative(ev)
+	assert.Nil(t, err)
+	assert.Equal(t, "Payment", typ)
+}
+
+func TestXSD10RejectsAssertions(t *testing.T) {
+	_, err := xsd2go.Generate(context.Background(), xsd2go.Options{
+		XSDPath:    "testdata/xsd11/shipment.xsd",
+		XSDVersion: xsd.XSDVersion10,
+	})
+	assert.EqualError(t, err, "xsd:assert used in testdata/xsd11/shipment.xsd requires XSD version 1.1")
+}